@@ -12,8 +12,14 @@ import (
 
 	"github.com/go-jose/go-jose/v3"
 	"github.com/go-jose/go-jose/v3/jwt"
+	"golang.org/x/oauth2"
 )
 
+// appInstallationTokenEarlyExpiry is how long before its expiry an
+// installation token is refreshed, so that requests already in flight don't
+// race against the expiry.
+const appInstallationTokenEarlyExpiry = 5 * time.Minute
+
 // GenerateOAuthTokenFromApp generates a GitHub OAuth access token from a set of valid GitHub App credentials.
 // The returned token can be used to interact with both GitHub's REST and GraphQL APIs.
 func GenerateOAuthTokenFromApp(baseURL, appID, appInstallationID, pemData string) (string, error) {
@@ -30,7 +36,49 @@ func GenerateOAuthTokenFromApp(baseURL, appID, appInstallationID, pemData string
 	return token, nil
 }
 
+// NewAppInstallationTokenSource returns a token source that generates GitHub App
+// installation tokens, transparently requesting a new one before the current
+// token expires. Installation tokens are only valid for one hour, which long
+// running applies would otherwise outlive. The tokens are requested with the
+// given HTTP client.
+func NewAppInstallationTokenSource(client *http.Client, baseURL, appID, appInstallationID, pemData string) oauth2.TokenSource {
+	src := &appInstallationTokenSource{
+		client:         client,
+		baseURL:        baseURL,
+		appID:          appID,
+		installationID: appInstallationID,
+		pemData:        []byte(pemData),
+	}
+	return oauth2.ReuseTokenSourceWithExpiry(nil, src, appInstallationTokenEarlyExpiry)
+}
+
+type appInstallationTokenSource struct {
+	client         *http.Client
+	baseURL        string
+	appID          string
+	installationID string
+	pemData        []byte
+}
+
+func (s *appInstallationTokenSource) Token() (*oauth2.Token, error) {
+	appJWT, err := generateAppJWT(s.appID, time.Now(), s.pemData)
+	if err != nil {
+		return nil, err
+	}
+
+	return requestInstallationAccessToken(s.client, s.baseURL, appJWT, s.installationID)
+}
+
 func getInstallationAccessToken(baseURL string, jwt string, installationID string) (string, error) {
+	token, err := requestInstallationAccessToken(http.DefaultClient, baseURL, jwt, installationID)
+	if err != nil {
+		return "", err
+	}
+
+	return token.AccessToken, nil
+}
+
+func requestInstallationAccessToken(client *http.Client, baseURL string, jwt string, installationID string) (*oauth2.Token, error) {
 	if baseURL != "https://api.github.com/" {
 		baseURL += "api/v3/"
 	}
@@ -39,37 +87,41 @@ func getInstallationAccessToken(baseURL string, jwt string, installationID strin
 
 	req, err := http.NewRequest(http.MethodPost, url, nil)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	req.Header.Add("Accept", "application/vnd.github.v3+json")
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", jwt))
 
-	res, err := http.DefaultClient.Do(req)
+	res, err := client.Do(req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer func() { _ = res.Body.Close() }()
 
 	resBytes, err := io.ReadAll(res.Body)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if res.StatusCode != http.StatusCreated {
-		return "", fmt.Errorf("failed to create OAuth token from GitHub App: %s", string(resBytes))
+		return nil, fmt.Errorf("failed to create OAuth token from GitHub App: %s", string(resBytes))
 	}
 
 	resData := struct {
-		Token string `json:"token"`
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
 	}{}
 
 	err = json.Unmarshal(resBytes, &resData)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return resData.Token, nil
+	return &oauth2.Token{
+		AccessToken: resData.Token,
+		Expiry:      resData.ExpiresAt,
+	}, nil
 }
 
 func generateAppJWT(appID string, now time.Time, pemData []byte) (string, error) {
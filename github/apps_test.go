@@ -5,6 +5,7 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"testing"
@@ -169,3 +170,34 @@ func TestGetInstallationAccessToken(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestAppInstallationTokenSource(t *testing.T) {
+	expiringSoon := time.Now().Add(time.Minute).UTC().Format(time.RFC3339)
+	expiringLater := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  fmt.Sprintf("/api/v3/app/installations/%s/access_tokens", testGitHubAppInstallationID),
+			ResponseBody: fmt.Sprintf(`{"token": "first", "expires_at": "%s"}`, expiringSoon),
+			StatusCode:   201,
+		},
+		{
+			ExpectedUri:  fmt.Sprintf("/api/v3/app/installations/%s/access_tokens", testGitHubAppInstallationID),
+			ResponseBody: fmt.Sprintf(`{"token": "second", "expires_at": "%s"}`, expiringLater),
+			StatusCode:   201,
+		},
+	})
+	defer ts.Close()
+
+	src := NewAppInstallationTokenSource(http.DefaultClient, ts.URL+"/", testGitHubAppID, testGitHubAppInstallationID, string(testGitHubAppPrivateKeyPemData))
+
+	for i, expected := range []string{"first", "second", "second"} {
+		token, err := src.Token()
+		if err != nil {
+			t.Fatalf("Unexpected error on call %d: %s", i, err)
+		}
+		if token.AccessToken != expected {
+			t.Fatalf("Unexpected access token on call %d - Found: %s - Expected: %s", i, token.AccessToken, expected)
+		}
+	}
+}
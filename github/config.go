@@ -2,6 +2,8 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"net/http"
 	"net/url"
 	"path"
@@ -11,11 +13,13 @@ import (
 	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/shurcooL/githubv4"
+	"golang.org/x/net/http/httpproxy"
 	"golang.org/x/oauth2"
 )
 
 type Config struct {
 	Token            string
+	TokenSource      oauth2.TokenSource
 	Owner            string
 	BaseURL          string
 	Insecure         bool
@@ -25,21 +29,25 @@ type Config struct {
 	RetryableErrors  map[int]bool
 	MaxRetries       int
 	ParallelRequests bool
+	RateLimitBuffer  int
+	ProxyURL         string
+	ExtraHeaders     map[string]string
 }
 
 type Owner struct {
-	name           string
-	id             int64
-	v3client       *github.Client
-	v4client       *githubv4.Client
-	StopContext    context.Context
-	IsOrganization bool
+	name             string
+	id               int64
+	v3client         *github.Client
+	v4client         *githubv4.Client
+	StopContext      context.Context
+	IsOrganization   bool
+	parallelRequests bool
 }
 
-func RateLimitedHTTPClient(client *http.Client, writeDelay time.Duration, readDelay time.Duration, retryDelay time.Duration, parallelRequests bool, retryableErrors map[int]bool, maxRetries int) *http.Client {
+func RateLimitedHTTPClient(client *http.Client, writeDelay time.Duration, readDelay time.Duration, retryDelay time.Duration, parallelRequests bool, retryableErrors map[int]bool, maxRetries int, rateLimitBuffer int) *http.Client {
 
 	client.Transport = NewEtagTransport(client.Transport)
-	client.Transport = NewRateLimitTransport(client.Transport, WithWriteDelay(writeDelay), WithReadDelay(readDelay), WithParallelRequests(parallelRequests))
+	client.Transport = NewRateLimitTransport(client.Transport, WithWriteDelay(writeDelay), WithReadDelay(readDelay), WithParallelRequests(parallelRequests), WithRateLimitBuffer(rateLimitBuffer))
 	client.Transport = logging.NewSubsystemLoggingHTTPTransport("GitHub", client.Transport)
 	client.Transport = newPreviewHeaderInjectorTransport(map[string]string{
 		// TODO: remove when Stone Crop preview is moved to general availability in the GraphQL API
@@ -53,24 +61,57 @@ func RateLimitedHTTPClient(client *http.Client, writeDelay time.Duration, readDe
 	return client
 }
 
-func (c *Config) AuthenticatedHTTPClient() *http.Client {
+func (c *Config) AuthenticatedHTTPClient() (*http.Client, error) {
+
+	transport, err := c.BaseTransport()
+	if err != nil {
+		return nil, err
+	}
 
 	ctx := context.Background()
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: c.Token},
-	)
+	ts := c.TokenSource
+	if ts == nil {
+		ts = oauth2.StaticTokenSource(
+			&oauth2.Token{AccessToken: c.Token},
+		)
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Transport: transport})
 	client := oauth2.NewClient(ctx, ts)
 
-	return RateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.RetryDelay, c.ParallelRequests, c.RetryableErrors, c.MaxRetries)
+	client = RateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.RetryDelay, c.ParallelRequests, c.RetryableErrors, c.MaxRetries, c.RateLimitBuffer)
+	if c.FineGrainedToken() {
+		client.Transport = NewFineGrainedTokenTransport(client.Transport)
+	}
+
+	return client, nil
+}
+
+// FineGrainedToken reports whether the configured token is a fine-grained
+// personal access token.
+func (c *Config) FineGrainedToken() bool {
+	return strings.HasPrefix(c.Token, fineGrainedTokenPrefix)
 }
 
 func (c *Config) Anonymous() bool {
 	return c.Token == ""
 }
 
-func (c *Config) AnonymousHTTPClient() *http.Client {
-	client := &http.Client{Transport: &http.Transport{}}
-	return RateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.RetryDelay, c.ParallelRequests, c.RetryableErrors, c.MaxRetries)
+func (c *Config) AnonymousHTTPClient() (*http.Client, error) {
+	transport, err := c.BaseTransport()
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{Transport: transport}
+	return RateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.RetryDelay, c.ParallelRequests, c.RetryableErrors, c.MaxRetries, c.RateLimitBuffer), nil
+}
+
+// BaseTransport returns the transport all requests to GitHub are sent with,
+// honoring the proxy and extra headers of the provider configuration. An
+// invalid proxy or extra header is an error rather than being ignored, so
+// requests are never sent around the proxy by mistake.
+func (c *Config) BaseTransport() (http.RoundTripper, error) {
+	return newBaseTransport(c.ProxyURL, c.ExtraHeaders)
 }
 
 func (c *Config) NewGraphQLClient(client *http.Client) (*githubv4.Client, error) {
@@ -117,6 +158,12 @@ func (c *Config) ConfigureOwner(owner *Owner) (*Owner, error) {
 		if c.Anonymous() {
 			return owner, nil
 		}
+		if c.FineGrainedToken() {
+			// The resource owner of a fine-grained token isn't exposed by the
+			// API, so fall back to the user that created the token.
+			log.Printf("[WARN] No owner configured for a fine-grained personal access token, using the authenticated user. " +
+				"Set owner to the resource owner selected when creating the token to manage organization resources.")
+		}
 		// Discover authenticated user
 		user, _, err := owner.v3client.Users.Get(ctx, "")
 		if err != nil {
@@ -141,10 +188,14 @@ func (c *Config) ConfigureOwner(owner *Owner) (*Owner, error) {
 func (c *Config) Meta() (interface{}, error) {
 
 	var client *http.Client
+	var err error
 	if c.Anonymous() {
-		client = c.AnonymousHTTPClient()
+		client, err = c.AnonymousHTTPClient()
 	} else {
-		client = c.AuthenticatedHTTPClient()
+		client, err = c.AuthenticatedHTTPClient()
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	v3client, err := c.NewRESTClient(client)
@@ -161,6 +212,7 @@ func (c *Config) Meta() (interface{}, error) {
 	owner.v4client = v4client
 	owner.v3client = v3client
 	owner.StopContext = context.Background()
+	owner.parallelRequests = c.ParallelRequests
 
 	_, err = c.ConfigureOwner(&owner)
 	if err != nil {
@@ -193,3 +245,86 @@ func (injector *previewHeaderInjectorTransport) RoundTrip(req *http.Request) (*h
 	}
 	return injector.rt.RoundTrip(req)
 }
+
+// reservedExtraHeaders are set by the provider itself and can't be replaced
+// with extra_headers, as that would break authentication, API previews or
+// conditional requests.
+var reservedExtraHeaders = []string{"Authorization", "Accept", "If-None-Match"}
+
+// validateExtraHeaders rejects extra headers the provider sets itself.
+func validateExtraHeaders(headers map[string]string) error {
+	for name := range headers {
+		for _, reserved := range reservedExtraHeaders {
+			if strings.EqualFold(name, reserved) {
+				return fmt.Errorf("extra_headers can't set the %s header, it is set by the provider", reserved)
+			}
+		}
+	}
+	return nil
+}
+
+func validateExtraHeadersFunc(v interface{}, k string) ([]string, []error) {
+	headers := make(map[string]string)
+	for name := range v.(map[string]interface{}) {
+		headers[name] = ""
+	}
+	if err := validateExtraHeaders(headers); err != nil {
+		return nil, []error{err}
+	}
+	return nil, nil
+}
+
+// newBaseTransport returns the default transport, unless a proxy or extra
+// headers are configured. Without a proxy, the default transport already
+// honors the HTTPS_PROXY and NO_PROXY environment variables.
+func newBaseTransport(proxyURL string, extraHeaders map[string]string) (http.RoundTripper, error) {
+	transport := http.DefaultTransport
+	if proxyURL != "" {
+		proxy, err := url.Parse(proxyURL)
+		if err == nil && (proxy.Scheme == "" || proxy.Host == "") {
+			err = fmt.Errorf("a scheme and host are required")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url %q: %w", proxyURL, err)
+		}
+
+		proxyConfig := &httpproxy.Config{
+			HTTPProxy:  proxyURL,
+			HTTPSProxy: proxyURL,
+			NoProxy:    httpproxy.FromEnvironment().NoProxy,
+		}
+		proxyFunc := proxyConfig.ProxyFunc()
+
+		t := &http.Transport{}
+		if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+			t = defaultTransport.Clone()
+		}
+		t.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxyFunc(req.URL)
+		}
+		transport = t
+	}
+	if len(extraHeaders) > 0 {
+		if err := validateExtraHeaders(extraHeaders); err != nil {
+			return nil, err
+		}
+		transport = &extraHeadersTransport{rt: transport, headers: extraHeaders}
+	}
+	return transport, nil
+}
+
+// extraHeadersTransport adds the configured headers to every request,
+// replacing any value set for the same header. Headers the provider relies
+// on are rejected by validateExtraHeaders beforehand.
+type extraHeadersTransport struct {
+	rt      http.RoundTripper
+	headers map[string]string
+}
+
+func (t *extraHeadersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+	return t.rt.RoundTrip(req)
+}
@@ -2,6 +2,8 @@ package github
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/shurcooL/githubv4"
@@ -162,3 +164,89 @@ func TestAccConfigMeta(t *testing.T) {
 	})
 
 }
+
+func TestNewBaseTransport(t *testing.T) {
+	t.Run("honors NO_PROXY when proxying requests", func(t *testing.T) {
+		t.Setenv("NO_PROXY", "internal.example.com, .corp.example.com,10.0.0.0/8,ghes.example.com:8443,,")
+		t.Setenv("no_proxy", "")
+
+		transport, err := newBaseTransport("http://proxy.example.com:3128", nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		proxyFunc := transport.(*http.Transport).Proxy
+
+		cases := map[string]bool{
+			"https://api.github.com/user":                   true,
+			"https://internal.example.com/api/v3/":          false,
+			"https://git.internal.example.com/api/v3/":      false,
+			"https://git.corp.example.com/api/v3/":          false,
+			"https://notcorp.example.com/api/v3/":           true,
+			"https://10.1.2.3/api/v3/":                      false,
+			"https://11.1.2.3/api/v3/":                      true,
+			"https://ghes.example.com:8443/api/v3/":         false,
+			"https://ghes.example.com/api/v3/":              true,
+			"https://INTERNAL.example.com/api/graphql":      false,
+			"https://internal.example.com.evil.com/api/v3/": true,
+		}
+		for rawURL, proxied := range cases {
+			req, _ := http.NewRequest("GET", rawURL, nil)
+			got, err := proxyFunc(req)
+			if err != nil {
+				t.Fatalf("Expected no error, got %s", err)
+			}
+			if (got != nil) != proxied {
+				t.Errorf("Expected %s to be proxied: %t, got proxy %v", rawURL, proxied, got)
+			}
+		}
+	})
+
+	t.Run("rejects an invalid proxy URL", func(t *testing.T) {
+		for _, proxyURL := range []string{"proxy.example.com:3128", "http://[::1", "://proxy"} {
+			if _, err := newBaseTransport(proxyURL, nil); err == nil {
+				t.Errorf("Expected an error for proxy URL %q", proxyURL)
+			}
+		}
+	})
+
+	t.Run("rejects extra headers set by the provider", func(t *testing.T) {
+		for _, name := range []string{"Authorization", "accept", "If-None-Match"} {
+			if _, err := newBaseTransport("", map[string]string{name: "value"}); err == nil {
+				t.Errorf("Expected an error for extra header %q", name)
+			}
+		}
+	})
+}
+
+func TestExtraHeadersTransport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Gateway-Token") != "abc" || r.Header.Get("User-Agent") != "custom" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	transport, err := newBaseTransport("", map[string]string{
+		"X-Gateway-Token": "abc",
+		"User-Agent":      "custom",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	client := &http.Client{Transport: transport}
+
+	req, _ := http.NewRequest("GET", ts.URL, nil)
+	req.Header.Set("User-Agent", "go-github")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected the extra headers to be sent, got status %d", resp.StatusCode)
+	}
+	if req.Header.Get("User-Agent") != "go-github" {
+		t.Error("Expected the original request to be left unchanged")
+	}
+}
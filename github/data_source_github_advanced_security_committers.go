@@ -0,0 +1,123 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubAdvancedSecurityCommitters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubAdvancedSecurityCommittersRead,
+
+		Schema: map[string]*schema.Schema{
+			"total_committers": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of unique active committers using GitHub Advanced Security across the organization.",
+			},
+			"maximum_committers": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum number of active committers using GitHub Advanced Security in the current billing cycle.",
+			},
+			"purchased_committers": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of GitHub Advanced Security committers purchased by the organization.",
+			},
+			"repositories": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The active committers of each repository using GitHub Advanced Security.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"full_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"committers": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"committer_logins": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubAdvancedSecurityCommittersRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	committers, err := listAdvancedSecurityCommitters(ctx, meta)
+	if err != nil {
+		return err
+	}
+
+	repositories := make([]interface{}, 0, len(committers.Repositories))
+	for _, repo := range committers.Repositories {
+		logins := make([]string, 0, len(repo.AdvancedSecurityCommittersBreakdown))
+		for _, committer := range repo.AdvancedSecurityCommittersBreakdown {
+			logins = append(logins, committer.GetUserLogin())
+		}
+		repositories = append(repositories, map[string]interface{}{
+			"full_name":        repo.GetName(),
+			"committers":       repo.GetAdvancedSecurityCommitters(),
+			"committer_logins": logins,
+		})
+	}
+
+	d.SetId(orgName)
+	if err = d.Set("total_committers", committers.TotalAdvancedSecurityCommitters); err != nil {
+		return err
+	}
+	if err = d.Set("maximum_committers", committers.MaximumAdvancedSecurityCommitters); err != nil {
+		return err
+	}
+	if err = d.Set("purchased_committers", committers.PurchasedAdvancedSecurityCommitters); err != nil {
+		return err
+	}
+	return d.Set("repositories", repositories)
+}
+
+// listAdvancedSecurityCommitters returns the GitHub Advanced Security active
+// committers of the organization, with the repositories of all pages.
+func listAdvancedSecurityCommitters(ctx context.Context, meta interface{}) (*github.ActiveCommitters, error) {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	options := &github.ListOptions{PerPage: maxPerPage}
+	var result *github.ActiveCommitters
+	for {
+		committers, resp, err := client.Billing.GetAdvancedSecurityActiveCommittersOrg(ctx, orgName, options)
+		if err != nil {
+			return nil, err
+		}
+
+		if result == nil {
+			result = committers
+		} else {
+			result.Repositories = append(result.Repositories, committers.Repositories...)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return result, nil
+}
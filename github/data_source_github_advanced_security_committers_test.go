@@ -0,0 +1,59 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceGithubAdvancedSecurityCommittersRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:     "/orgs/example/settings/billing/advanced-security?per_page=100",
+			ExpectedMethod:  "GET",
+			ResponseHeaders: map[string]string{"Link": `<https://api.github.com/orgs/example/settings/billing/advanced-security?per_page=100&page=2>; rel="next"`},
+			ResponseBody: `{"total_advanced_security_committers": 2, "purchased_advanced_security_committers": 10, "repositories": [
+				{"name": "example/one", "advanced_security_committers": 1, "advanced_security_committers_breakdown": [{"user_login": "alice"}]}
+			]}`,
+			StatusCode: http.StatusOK,
+		},
+		{
+			ExpectedUri:    "/orgs/example/settings/billing/advanced-security?page=2&per_page=100",
+			ExpectedMethod: "GET",
+			ResponseBody: `{"total_advanced_security_committers": 2, "purchased_advanced_security_committers": 10, "repositories": [
+				{"name": "example/two", "advanced_security_committers": 1, "advanced_security_committers_breakdown": [{"user_login": "bob"}]}
+			]}`,
+			StatusCode: http.StatusOK,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubAdvancedSecurityCommitters().Schema, map[string]interface{}{})
+
+	err := dataSourceGithubAdvancedSecurityCommittersRead(d, meta)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	if v := d.Get("total_committers").(int); v != 2 {
+		t.Errorf("Expected 2 committers, got %d", v)
+	}
+	if v := d.Get("purchased_committers").(int); v != 10 {
+		t.Errorf("Expected 10 purchased committers, got %d", v)
+	}
+	if count := d.Get("repositories.#").(int); count != 2 {
+		t.Fatalf("Expected 2 repositories, got %d", count)
+	}
+	if login := d.Get("repositories.1.committer_logins.0").(string); login != "bob" {
+		t.Errorf("Unexpected committer %q", login)
+	}
+}
@@ -0,0 +1,198 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGithubAuditLogGitEvents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubAuditLogGitEventsRead,
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The slug of the enterprise.",
+			},
+			"after": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: toDiagFunc(validation.IsRFC3339Time, "after"),
+				Description:      "The start of the time window, as an RFC 3339 timestamp.",
+			},
+			"before": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: toDiagFunc(validation.IsRFC3339Time, "before"),
+				Description:      "The end of the time window, as an RFC 3339 timestamp. Defaults to now.",
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+					"git.clone", "git.fetch", "git.push",
+				}, false), "action"),
+				Description: "Only return events of this action. Can be one of 'git.clone', 'git.fetch' or 'git.push'.",
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return events of this repository, given as 'owner/name'.",
+			},
+			"actor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return events performed by this user.",
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"document_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"actor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"actor_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"country_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repository": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repository_public": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"transport_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"programmatic_access_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubAuditLogGitEventsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+	phrase := buildAuditLogGitEventsPhrase(d)
+
+	options := &github.GetAuditLogOptions{
+		Phrase:  github.String(phrase),
+		Include: github.String("git"),
+		Order:   github.String("asc"),
+		ListCursorOptions: github.ListCursorOptions{
+			PerPage: maxPerPage,
+		},
+	}
+
+	events := make([]interface{}, 0)
+	for {
+		entries, resp, err := client.Enterprise.GetAuditLog(ctx, enterpriseSlug, options)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			events = append(events, flattenAuditLogGitEvent(entry))
+		}
+
+		if resp.After == "" {
+			break
+		}
+		options.After = resp.After
+	}
+
+	d.SetId(buildTwoPartID(enterpriseSlug, phrase))
+	return d.Set("events", events)
+}
+
+// buildAuditLogGitEventsPhrase builds the audit log search phrase for the
+// configured time window and filters.
+func buildAuditLogGitEventsPhrase(d *schema.ResourceData) string {
+	// The arguments have already been validated as RFC 3339 timestamps.
+	after, _ := time.Parse(time.RFC3339, d.Get("after").(string))
+	window := fmt.Sprintf("created:>=%s", after.UTC().Format(time.RFC3339))
+	if v, ok := d.GetOk("before"); ok {
+		before, _ := time.Parse(time.RFC3339, v.(string))
+		window = fmt.Sprintf("created:%s..%s", after.UTC().Format(time.RFC3339), before.UTC().Format(time.RFC3339))
+	}
+
+	terms := []string{window}
+	if v, ok := d.GetOk("action"); ok {
+		terms = append(terms, fmt.Sprintf("action:%s", v.(string)))
+	}
+	if v, ok := d.GetOk("repository"); ok {
+		terms = append(terms, fmt.Sprintf("repo:%s", v.(string)))
+	}
+	if v, ok := d.GetOk("actor"); ok {
+		terms = append(terms, fmt.Sprintf("actor:%s", v.(string)))
+	}
+
+	return strings.Join(terms, " ")
+}
+
+func flattenAuditLogGitEvent(entry *github.AuditEntry) map[string]interface{} {
+	// Git specific fields aren't part of the generic audit log entry.
+	stringField := func(key string) string {
+		v, _ := entry.AdditionalFields[key].(string)
+		return v
+	}
+	repositoryPublic, _ := entry.AdditionalFields["repository_public"].(bool)
+
+	repository := stringField("repo")
+	if repository == "" {
+		repository = stringField("repository")
+	}
+
+	timestamp := ""
+	if entry.Timestamp != nil {
+		timestamp = entry.Timestamp.UTC().Format(time.RFC3339)
+	}
+
+	return map[string]interface{}{
+		"document_id":              entry.GetDocumentID(),
+		"action":                   entry.GetAction(),
+		"actor":                    entry.GetActor(),
+		"actor_id":                 entry.GetActorID(),
+		"country_code":             entry.GetActorLocation().GetCountryCode(),
+		"repository":               repository,
+		"repository_public":        repositoryPublic,
+		"transport_protocol":       stringField("transport_protocol_name"),
+		"programmatic_access_type": stringField("programmatic_access_type"),
+		"timestamp":                timestamp,
+	}
+}
@@ -0,0 +1,113 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccGithubAuditLogGitEventsDataSource(t *testing.T) {
+	if isEnterprise != "true" {
+		t.Skip("Skipping because `ENTERPRISE_ACCOUNT` is not set or set to false")
+	}
+
+	if testEnterprise == "" {
+		t.Skip("Skipping because `ENTERPRISE_SLUG` is not set")
+	}
+
+	config := fmt.Sprintf(`
+			data "github_audit_log_git_events" "test" {
+				enterprise_slug = "%s"
+				after           = "%s"
+				action          = "git.clone"
+			}
+		`,
+		testEnterprise,
+		time.Now().Add(-24*time.Hour).UTC().Format(time.RFC3339),
+	)
+
+	check := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrSet("data.github_audit_log_git_events.test", "events.#"),
+	)
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck:  func() { skipUnlessMode(t, enterprise) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check:  check,
+				},
+			},
+		},
+	)
+}
+
+func TestBuildAuditLogGitEventsPhrase(t *testing.T) {
+	s := dataSourceGithubAuditLogGitEvents().Schema
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"enterprise_slug": "test",
+		"after":           "2024-01-01T00:00:00+01:00",
+	})
+	expected := "created:>=2023-12-31T23:00:00Z"
+	if phrase := buildAuditLogGitEventsPhrase(d); phrase != expected {
+		t.Fatalf("Expected %q, got %q", expected, phrase)
+	}
+
+	d = schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"enterprise_slug": "test",
+		"after":           "2024-01-01T00:00:00Z",
+		"before":          "2024-01-31T00:00:00Z",
+		"action":          "git.push",
+		"repository":      "test/blah",
+		"actor":           "octocat",
+	})
+	expected = "created:2024-01-01T00:00:00Z..2024-01-31T00:00:00Z action:git.push repo:test/blah actor:octocat"
+	if phrase := buildAuditLogGitEventsPhrase(d); phrase != expected {
+		t.Fatalf("Expected %q, got %q", expected, phrase)
+	}
+}
+
+func TestFlattenAuditLogGitEvent(t *testing.T) {
+	entry := new(github.AuditEntry)
+	err := json.Unmarshal([]byte(`{
+		"@timestamp": 1704067200000,
+		"_document_id": "abc",
+		"action": "git.clone",
+		"actor": "octocat",
+		"actor_id": 1,
+		"actor_location": {"country_code": "SE"},
+		"repo": "test/blah",
+		"repository_public": false,
+		"transport_protocol_name": "http"
+	}`), entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event := flattenAuditLogGitEvent(entry)
+	expected := map[string]interface{}{
+		"document_id":        "abc",
+		"action":             "git.clone",
+		"actor":              "octocat",
+		"actor_id":           int64(1),
+		"country_code":       "SE",
+		"repository":         "test/blah",
+		"repository_public":  false,
+		"transport_protocol": "http",
+		"timestamp":          "2024-01-01T00:00:00Z",
+	}
+	for key, value := range expected {
+		if event[key] != value {
+			t.Fatalf("Expected %s to be %v, got %v", key, value, event[key])
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGithubCodeScanningAlerts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubCodeScanningAlertsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the repository to list the alerts of. If not set, the alerts of the organization are listed.",
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "open",
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"open", "closed", "dismissed", "fixed"}, false), "state"),
+				Description:      "The state of the alerts to list. Can be one of 'open', 'closed', 'dismissed' or 'fixed'.",
+			},
+			"severity": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"critical", "high", "medium", "low", "warning", "note", "error"}, false), "severity"),
+				Description:      "The severity of the alerts to list. Can be one of 'critical', 'high', 'medium', 'low', 'warning', 'note' or 'error'.",
+			},
+			"tool_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the code scanning tool to list the alerts of, e.g. 'CodeQL'.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Git reference to list the alerts of, e.g. 'refs/heads/main'. Only used when 'repository' is set.",
+			},
+			"alerts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"repository": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_severity_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tool_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ref": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubCodeScanningAlertsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	ctx := context.Background()
+
+	if repoName == "" {
+		if err := checkOrganization(meta); err != nil {
+			return err
+		}
+	}
+
+	options := &github.AlertListOptions{
+		State:             d.Get("state").(string),
+		Severity:          d.Get("severity").(string),
+		ToolName:          d.Get("tool_name").(string),
+		ListCursorOptions: github.ListCursorOptions{PerPage: maxPerPage},
+	}
+	if repoName != "" {
+		options.Ref = d.Get("ref").(string)
+	}
+
+	alerts := make([]interface{}, 0)
+	for {
+		var page []*github.Alert
+		var resp *github.Response
+		var err error
+		if repoName != "" {
+			page, resp, err = client.CodeScanning.ListAlertsForRepo(ctx, owner, repoName, options)
+		} else {
+			page, resp, err = client.CodeScanning.ListAlertsForOrg(ctx, owner, options)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, alert := range page {
+			// The repository is only included in the alerts of organizations.
+			repository := alert.GetRepository().GetFullName()
+			if repository == "" {
+				repository = owner + "/" + repoName
+			}
+			createdAt := ""
+			if alert.CreatedAt != nil {
+				createdAt = alert.CreatedAt.String()
+			}
+
+			alerts = append(alerts, map[string]interface{}{
+				"number":                  alert.GetNumber(),
+				"repository":              repository,
+				"state":                   alert.GetState(),
+				"rule_id":                 alert.GetRule().GetID(),
+				"rule_description":        alert.GetRule().GetDescription(),
+				"severity":                alert.GetRule().GetSeverity(),
+				"security_severity_level": alert.GetRule().GetSecuritySeverityLevel(),
+				"tool_name":               alert.GetTool().GetName(),
+				"ref":                     alert.GetMostRecentInstance().GetRef(),
+				"path":                    alert.GetMostRecentInstance().GetLocation().GetPath(),
+				"html_url":                alert.GetHTMLURL(),
+				"created_at":              createdAt,
+			})
+		}
+
+		// Depending on the endpoint and GitHub version, the alerts are
+		// paginated by cursor or by page number.
+		if resp.After != "" {
+			options.After = resp.After
+		} else if resp.NextPage != 0 {
+			options.ListOptions.Page = resp.NextPage
+		} else {
+			break
+		}
+	}
+
+	if repoName != "" {
+		d.SetId(buildTwoPartID(owner, repoName))
+	} else {
+		d.SetId(owner)
+	}
+
+	return d.Set("alerts", alerts)
+}
@@ -0,0 +1,72 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceGithubCodeScanningAlertsRead(t *testing.T) {
+	t.Run("lists the filtered alerts of a repository across numbered pages", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:     "/repos/example/repo/code-scanning/alerts?per_page=100&ref=refs%2Fheads%2Fmain&severity=high&state=open",
+				ExpectedMethod:  "GET",
+				ResponseHeaders: map[string]string{"Link": `<https://api.github.com/repos/example/repo/code-scanning/alerts?page=2>; rel="next"`},
+				ResponseBody:    `[{"number": 1, "state": "open", "rule": {"id": "js/xss", "severity": "error", "security_severity_level": "high"}, "tool": {"name": "CodeQL"}, "most_recent_instance": {"ref": "refs/heads/main", "location": {"path": "src/app.js"}}}]`,
+				StatusCode:      http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/code-scanning/alerts?page=2&per_page=100&ref=refs%2Fheads%2Fmain&severity=high&state=open",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"number": 2, "state": "open", "rule": {"id": "js/sql-injection"}, "tool": {"name": "CodeQL"}}]`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client}
+
+		d := schema.TestResourceDataRaw(t, dataSourceGithubCodeScanningAlerts().Schema, map[string]interface{}{
+			"repository": "repo",
+			"severity":   "high",
+			"ref":        "refs/heads/main",
+		})
+
+		err := dataSourceGithubCodeScanningAlertsRead(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if count := d.Get("alerts.#").(int); count != 2 {
+			t.Fatalf("Expected 2 alerts, got %d", count)
+		}
+		if v := d.Get("alerts.0.path").(string); v != "src/app.js" {
+			t.Errorf("Unexpected path %q", v)
+		}
+		if v := d.Get("alerts.0.security_severity_level").(string); v != "high" {
+			t.Errorf("Unexpected security severity level %q", v)
+		}
+		if v := d.Get("alerts.1.repository").(string); v != "example/repo" {
+			t.Errorf("Unexpected repository %q", v)
+		}
+	})
+
+	t.Run("requires an organization without a repository", func(t *testing.T) {
+		meta := &Owner{name: "example", v3client: github.NewClient(nil)}
+
+		d := schema.TestResourceDataRaw(t, dataSourceGithubCodeScanningAlerts().Schema, map[string]interface{}{})
+
+		err := dataSourceGithubCodeScanningAlertsRead(d, meta)
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+	})
+}
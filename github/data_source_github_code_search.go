@@ -0,0 +1,143 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGithubCodeSearch() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubCodeSearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The code search query, including qualifiers such as 'org:' or 'language:'.",
+			},
+			"results_per_page": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				ValidateDiagFunc: toDiagFunc(validation.IntBetween(1, 100), "results_per_page"),
+			},
+			"max_results": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1000,
+				ValidateDiagFunc: toDiagFunc(validation.IntBetween(1, 1000), "max_results"),
+				Description:      "The maximum number of results to fetch. Code search never returns more than 1000 results.",
+			},
+			"total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"incomplete_results": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sha": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fragments": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubCodeSearchRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+
+	query := d.Get("query").(string)
+	maxResults := d.Get("max_results").(int)
+	opt := &github.SearchOptions{
+		TextMatch: true,
+		ListOptions: github.ListOptions{
+			PerPage: d.Get("results_per_page").(int),
+		},
+	}
+
+	results := make([]interface{}, 0)
+	var totalCount int
+	var incompleteResults bool
+
+	for {
+		page, resp, err := client.Search.Code(context.Background(), query, opt)
+		if err != nil {
+			return err
+		}
+
+		totalCount = page.GetTotal()
+		incompleteResults = incompleteResults || page.GetIncompleteResults()
+
+		for _, result := range page.CodeResults {
+			if len(results) == maxResults {
+				break
+			}
+			results = append(results, flattenCodeResult(result))
+		}
+
+		if resp.NextPage == 0 || len(results) == maxResults {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	d.SetId(query)
+	err := d.Set("total_count", totalCount)
+	if err != nil {
+		return err
+	}
+	err = d.Set("incomplete_results", incompleteResults)
+	if err != nil {
+		return err
+	}
+	err = d.Set("results", results)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenCodeResult(result *github.CodeResult) map[string]interface{} {
+	fragments := make([]string, 0, len(result.TextMatches))
+	for _, match := range result.TextMatches {
+		fragments = append(fragments, match.GetFragment())
+	}
+
+	return map[string]interface{}{
+		"repository": result.GetRepository().GetFullName(),
+		"path":       result.GetPath(),
+		"sha":        result.GetSHA(),
+		"html_url":   result.GetHTMLURL(),
+		"fragments":  fragments,
+	}
+}
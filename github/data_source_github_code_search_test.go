@@ -0,0 +1,78 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFlattenCodeResult(t *testing.T) {
+	result := &github.CodeResult{
+		Path:    github.String("main.go"),
+		SHA:     github.String("abc123"),
+		HTMLURL: github.String("https://github.com/octo-org/hello-world/blob/abc123/main.go"),
+		Repository: &github.Repository{
+			FullName: github.String("octo-org/hello-world"),
+		},
+		TextMatches: []*github.TextMatch{
+			{Fragment: github.String(`endpoint := "https://internal.example.com"`)},
+			{Fragment: github.String(`// https://internal.example.com`)},
+		},
+	}
+
+	flattened := flattenCodeResult(result)
+
+	if flattened["repository"] != "octo-org/hello-world" {
+		t.Errorf("Expected repository octo-org/hello-world, got %v", flattened["repository"])
+	}
+	if flattened["path"] != "main.go" {
+		t.Errorf("Expected path main.go, got %v", flattened["path"])
+	}
+	fragments := flattened["fragments"].([]string)
+	if len(fragments) != 2 || fragments[0] != `endpoint := "https://internal.example.com"` {
+		t.Errorf("Expected both fragments in order, got %v", fragments)
+	}
+}
+
+func TestAccGithubCodeSearchDataSource(t *testing.T) {
+	t.Run("searches code of an organization without error", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			data "github_code_search" "test" {
+				query       = "org:%s README"
+				max_results = 5
+			}
+		`, testOrganization)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet("data.github_code_search.test", "total_count"),
+			resource.TestCheckResourceAttrSet("data.github_code_search.test", "incomplete_results"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -0,0 +1,74 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubCollaboratorPermission() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubCollaboratorPermissionRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository. The owner may be passed as part of the name, e.g. 'owner/repo'.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The login of the user to look up.",
+			},
+			"permission": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The effective base permission of the user on the repository. One of 'admin', 'push', 'pull' or 'none'.",
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the role the user holds on the repository, including custom repository roles.",
+			},
+			"is_custom_role": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether 'role_name' refers to a custom repository role.",
+			},
+		},
+	}
+}
+
+func dataSourceGithubCollaboratorPermissionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+
+	repoName := d.Get("repository").(string)
+	username := d.Get("username").(string)
+	owner, repoNameWithoutOwner := parseRepoName(repoName, meta.(*Owner).name)
+
+	permissionLevel, _, err := client.Repositories.GetPermissionLevel(ctx, owner, repoNameWithoutOwner, username)
+	if err != nil {
+		return fmt.Errorf("error querying permission of %s on %s/%s: %s", username, owner, repoNameWithoutOwner, err)
+	}
+
+	roleName := getPermission(permissionLevel.GetRoleName())
+
+	d.SetId(buildTwoPartID(repoName, username))
+	err = d.Set("permission", getPermission(permissionLevel.GetPermission()))
+	if err != nil {
+		return err
+	}
+	err = d.Set("role_name", roleName)
+	if err != nil {
+		return err
+	}
+	err = d.Set("is_custom_role", roleName != "" && !isBaseRepositoryPermission(roleName))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
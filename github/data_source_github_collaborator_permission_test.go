@@ -0,0 +1,64 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubCollaboratorPermissionDataSource(t *testing.T) {
+
+	t.Run("queries the permission of a collaborator", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name = "tf-acc-test-%s"
+			}
+
+			data "github_collaborator_permission" "test" {
+				repository = github_repository.test.name
+				username   = "%s"
+			}
+		`, randomID, testOwnerFunc())
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(
+				"data.github_collaborator_permission.test", "permission", "admin",
+			),
+			resource.TestCheckResourceAttr(
+				"data.github_collaborator_permission.test", "role_name", "admin",
+			),
+			resource.TestCheckResourceAttr(
+				"data.github_collaborator_permission.test", "is_custom_role", "false",
+			),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			t.Skip("organization account does not own the repository as a user")
+		})
+	})
+}
@@ -0,0 +1,166 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGithubCommitsSearch() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubCommitsSearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The commit search query, including qualifiers such as 'org:', 'author:' or 'committer-date:'.",
+			},
+			"sort": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"author-date", "committer-date"}, false), "sort"),
+				Description:      "The field to sort the results by. Defaults to best match.",
+			},
+			"order": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "desc",
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"asc", "desc"}, false), "order"),
+			},
+			"results_per_page": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				ValidateDiagFunc: toDiagFunc(validation.IntBetween(1, 100), "results_per_page"),
+			},
+			"max_results": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1000,
+				ValidateDiagFunc: toDiagFunc(validation.IntBetween(0, 1000), "max_results"),
+				Description:      "The maximum number of results to fetch. Set to 0 to only fetch 'total_count'.",
+			},
+			"total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"incomplete_results": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"commits": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sha": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"author": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"author_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"committer_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubCommitsSearchRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+
+	query := d.Get("query").(string)
+	maxResults := d.Get("max_results").(int)
+	opt := &github.SearchOptions{
+		Sort:  d.Get("sort").(string),
+		Order: d.Get("order").(string),
+		ListOptions: github.ListOptions{
+			PerPage: searchResultsPerPage(d.Get("results_per_page").(int), maxResults),
+		},
+	}
+
+	commits := make([]interface{}, 0)
+	var totalCount int
+	var incompleteResults bool
+
+	for {
+		page, resp, err := client.Search.Commits(context.Background(), query, opt)
+		if err != nil {
+			return err
+		}
+
+		totalCount = page.GetTotal()
+		incompleteResults = incompleteResults || page.GetIncompleteResults()
+
+		for _, commit := range page.Commits {
+			if len(commits) >= maxResults {
+				break
+			}
+			commits = append(commits, flattenCommitSearchResult(commit))
+		}
+
+		if resp.NextPage == 0 || len(commits) >= maxResults {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	d.SetId(query)
+	err := d.Set("total_count", totalCount)
+	if err != nil {
+		return err
+	}
+	err = d.Set("incomplete_results", incompleteResults)
+	if err != nil {
+		return err
+	}
+	err = d.Set("commits", commits)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenCommitSearchResult(commit *github.CommitResult) map[string]interface{} {
+	// The author login is only known when the commit email matches a user.
+	author := commit.GetAuthor().GetLogin()
+	if author == "" {
+		author = commit.GetCommit().GetAuthor().GetName()
+	}
+
+	return map[string]interface{}{
+		"repository":     commit.GetRepository().GetFullName(),
+		"sha":            commit.GetSHA(),
+		"message":        commit.GetCommit().GetMessage(),
+		"author":         author,
+		"author_date":    commit.GetCommit().GetAuthor().GetDate().String(),
+		"committer_date": commit.GetCommit().GetCommitter().GetDate().String(),
+		"html_url":       commit.GetHTMLURL(),
+	}
+}
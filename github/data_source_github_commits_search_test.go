@@ -0,0 +1,79 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFlattenCommitSearchResult(t *testing.T) {
+	commit := &github.CommitResult{
+		SHA: github.String("abc123"),
+		Commit: &github.Commit{
+			Message: github.String("Fix the build"),
+			Author:  &github.CommitAuthor{Name: github.String("Mona Lisa")},
+		},
+		Repository: &github.Repository{FullName: github.String("octo-org/hello-world")},
+	}
+
+	flattened := flattenCommitSearchResult(commit)
+
+	if flattened["repository"] != "octo-org/hello-world" {
+		t.Errorf("Expected repository octo-org/hello-world, got %v", flattened["repository"])
+	}
+	if flattened["message"] != "Fix the build" {
+		t.Errorf("Expected message Fix the build, got %v", flattened["message"])
+	}
+	if flattened["author"] != "Mona Lisa" {
+		t.Errorf("Expected the commit author name without a matching user, got %v", flattened["author"])
+	}
+
+	commit.Author = &github.User{Login: github.String("monalisa")}
+	if author := flattenCommitSearchResult(commit)["author"]; author != "monalisa" {
+		t.Errorf("Expected the login of the matching user, got %v", author)
+	}
+}
+
+func TestAccGithubCommitsSearchDataSource(t *testing.T) {
+	t.Run("searches commits of an organization without error", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			data "github_commits_search" "test" {
+				query       = "org:%s"
+				sort        = "committer-date"
+				max_results = 5
+			}
+		`, testOrganization)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet("data.github_commits_search.test", "total_count"),
+			resource.TestCheckResourceAttrSet("data.github_commits_search.test", "commits.0.sha"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
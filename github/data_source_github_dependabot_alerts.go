@@ -0,0 +1,182 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubDependabotAlerts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubDependabotAlertsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the repository to list the alerts of. If not set, the alerts of the organization are listed.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A comma-separated list of states to filter the alerts by, e.g. 'open' or 'dismissed,fixed'.",
+			},
+			"severity": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A comma-separated list of severities to filter the alerts by, e.g. 'critical' or 'high,critical'.",
+			},
+			"ecosystem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A comma-separated list of ecosystems to filter the alerts by, e.g. 'npm' or 'pip,maven'.",
+			},
+			"package": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A comma-separated list of package names to filter the alerts by.",
+			},
+			"alerts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"repository": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ecosystem": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"package": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"manifest_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ghsa_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cve_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubDependabotAlertsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	ctx := context.Background()
+
+	if repoName == "" {
+		if err := checkOrganization(meta); err != nil {
+			return err
+		}
+	}
+
+	options := &github.ListAlertsOptions{
+		ListCursorOptions: github.ListCursorOptions{PerPage: maxPerPage},
+	}
+	if v, ok := d.GetOk("state"); ok {
+		options.State = github.String(v.(string))
+	}
+	if v, ok := d.GetOk("severity"); ok {
+		options.Severity = github.String(v.(string))
+	}
+	if v, ok := d.GetOk("ecosystem"); ok {
+		options.Ecosystem = github.String(v.(string))
+	}
+	if v, ok := d.GetOk("package"); ok {
+		options.Package = github.String(v.(string))
+	}
+
+	alerts := make([]interface{}, 0)
+	for {
+		var page []*github.DependabotAlert
+		var resp *github.Response
+		var err error
+		if repoName != "" {
+			page, resp, err = client.Dependabot.ListRepoAlerts(ctx, owner, repoName, options)
+		} else {
+			page, resp, err = client.Dependabot.ListOrgAlerts(ctx, owner, options)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, alert := range page {
+			// The repository is only included in the alerts of organizations.
+			repository := alert.GetRepository().GetFullName()
+			if repository == "" {
+				repository = owner + "/" + repoName
+			}
+			createdAt := ""
+			if alert.CreatedAt != nil {
+				createdAt = alert.CreatedAt.String()
+			}
+
+			alerts = append(alerts, map[string]interface{}{
+				"number":        alert.GetNumber(),
+				"repository":    repository,
+				"state":         alert.GetState(),
+				"severity":      alert.GetSecurityAdvisory().GetSeverity(),
+				"ecosystem":     alert.GetDependency().GetPackage().GetEcosystem(),
+				"package":       alert.GetDependency().GetPackage().GetName(),
+				"manifest_path": alert.GetDependency().GetManifestPath(),
+				"ghsa_id":       alert.GetSecurityAdvisory().GetGHSAID(),
+				"cve_id":        alert.GetSecurityAdvisory().GetCVEID(),
+				"summary":       alert.GetSecurityAdvisory().GetSummary(),
+				"html_url":      alert.GetHTMLURL(),
+				"created_at":    createdAt,
+			})
+		}
+
+		if resp.After == "" {
+			break
+		}
+		options.After = resp.After
+	}
+
+	if repoName != "" {
+		d.SetId(buildTwoPartID(owner, repoName))
+	} else {
+		d.SetId(owner)
+	}
+
+	return d.Set("alerts", alerts)
+}
@@ -0,0 +1,69 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceGithubDependabotAlertsRead(t *testing.T) {
+	t.Run("lists the filtered alerts of a repository across pages", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:     "/repos/example/repo/dependabot/alerts?per_page=100&severity=critical&state=open",
+				ExpectedMethod:  "GET",
+				ResponseHeaders: map[string]string{"Link": `<https://api.github.com/repos/example/repo/dependabot/alerts?after=abc>; rel="next"`},
+				ResponseBody:    `[{"number": 1, "state": "open", "dependency": {"package": {"ecosystem": "npm", "name": "lodash"}}, "security_advisory": {"severity": "critical", "ghsa_id": "GHSA-1"}}]`,
+				StatusCode:      http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/dependabot/alerts?after=abc&per_page=100&severity=critical&state=open",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"number": 2, "state": "open", "dependency": {"package": {"ecosystem": "pip", "name": "django"}}, "security_advisory": {"severity": "critical"}}]`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client}
+
+		d := schema.TestResourceDataRaw(t, dataSourceGithubDependabotAlerts().Schema, map[string]interface{}{
+			"repository": "repo",
+			"state":      "open",
+			"severity":   "critical",
+		})
+
+		err := dataSourceGithubDependabotAlertsRead(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if count := d.Get("alerts.#").(int); count != 2 {
+			t.Fatalf("Expected 2 alerts, got %d", count)
+		}
+		if v := d.Get("alerts.0.package").(string); v != "lodash" {
+			t.Errorf("Unexpected package %q", v)
+		}
+		if v := d.Get("alerts.1.repository").(string); v != "example/repo" {
+			t.Errorf("Unexpected repository %q", v)
+		}
+	})
+
+	t.Run("requires an organization without a repository", func(t *testing.T) {
+		meta := &Owner{name: "example", v3client: github.NewClient(nil)}
+
+		d := schema.TestResourceDataRaw(t, dataSourceGithubDependabotAlerts().Schema, map[string]interface{}{})
+
+		err := dataSourceGithubDependabotAlertsRead(d, meta)
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+	})
+}
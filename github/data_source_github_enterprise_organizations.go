@@ -0,0 +1,111 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func dataSourceGithubEnterpriseOrganizations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubEnterpriseOrganizationsRead,
+		Schema: map[string]*schema.Schema{
+			"enterprise_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"organizations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"database_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"login": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubEnterpriseOrganizationsRead(data *schema.ResourceData, meta interface{}) error {
+	var query struct {
+		Enterprise struct {
+			ID            githubv4.String
+			Organizations struct {
+				Nodes []struct {
+					ID         githubv4.String
+					DatabaseId githubv4.Int
+					Login      githubv4.String
+					Name       githubv4.String
+					CreatedAt  githubv4.String
+				}
+				PageInfo struct {
+					EndCursor   githubv4.String
+					HasNextPage bool
+				}
+			} `graphql:"organizations(first: 100, after: $cursor)"`
+		} `graphql:"enterprise(slug: $slug)"`
+	}
+
+	slug := data.Get("enterprise_slug").(string)
+	client := meta.(*Owner).v4client
+	variables := map[string]interface{}{
+		"slug":   githubv4.String(slug),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	organizations := make([]interface{}, 0)
+	for {
+		err := client.Query(context.Background(), &query, variables)
+		if err != nil {
+			return err
+		}
+		if query.Enterprise.ID == "" {
+			return fmt.Errorf("could not find enterprise %v", slug)
+		}
+
+		for _, org := range query.Enterprise.Organizations.Nodes {
+			organizations = append(organizations, map[string]interface{}{
+				"id":          string(org.ID),
+				"database_id": int(org.DatabaseId),
+				"login":       string(org.Login),
+				"name":        string(org.Name),
+				"created_at":  string(org.CreatedAt),
+			})
+		}
+
+		if !query.Enterprise.Organizations.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Enterprise.Organizations.PageInfo.EndCursor)
+	}
+
+	data.SetId(string(query.Enterprise.ID))
+	err := data.Set("organizations", organizations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubEnterpriseOrganizationsDataSource(t *testing.T) {
+	if isEnterprise != "true" {
+		t.Skip("Skipping because `ENTERPRISE_ACCOUNT` is not set or set to false")
+	}
+
+	if testEnterprise == "" {
+		t.Skip("Skipping because `ENTERPRISE_SLUG` is not set")
+	}
+
+	config := fmt.Sprintf(`
+			data "github_enterprise_organizations" "test" {
+				enterprise_slug = "%s"
+			}
+		`,
+		testEnterprise,
+	)
+
+	check := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrSet("data.github_enterprise_organizations.test", "organizations.#"),
+		resource.TestCheckResourceAttrSet("data.github_enterprise_organizations.test", "organizations.0.login"),
+		resource.TestCheckResourceAttrSet("data.github_enterprise_organizations.test", "organizations.0.database_id"),
+	)
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck:  func() { skipUnlessMode(t, enterprise) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check:  check,
+				},
+			},
+		},
+	)
+}
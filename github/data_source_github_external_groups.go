@@ -2,7 +2,6 @@ package github
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/google/go-github/v65/github"
@@ -13,6 +12,17 @@ func dataSourceGithubExternalGroups() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceGithubExternalGroupsRead,
 		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the external groups whose name contains this text.",
+			},
+			"include_counts": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to look up the number of members and teams of every group. This takes one request per group.",
+			},
 			"external_groups": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -30,6 +40,14 @@ func dataSourceGithubExternalGroups() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"member_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"team_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -44,11 +62,18 @@ func dataSourceGithubExternalGroupsRead(d *schema.ResourceData, meta interface{}
 	}
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
+	includeCounts := d.Get("include_counts").(bool)
 
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	opts := &github.ListExternalGroupsOptions{}
+	opts := &github.ListExternalGroupsOptions{
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+	displayName := d.Get("display_name").(string)
+	if displayName != "" {
+		opts.DisplayName = github.String(displayName)
+	}
 
-	externalGroups := new(github.ExternalGroupList)
+	groupsState := make([]map[string]interface{}, 0)
 
 	for {
 		groups, resp, err := client.Teams.ListExternalGroups(ctx, orgName, opts)
@@ -56,7 +81,24 @@ func dataSourceGithubExternalGroupsRead(d *schema.ResourceData, meta interface{}
 			return err
 		}
 
-		externalGroups.Groups = append(externalGroups.Groups, groups.Groups...)
+		for _, group := range groups.Groups {
+			groupState := map[string]interface{}{
+				"group_id":   group.GetGroupID(),
+				"group_name": group.GetGroupName(),
+				"updated_at": group.GetUpdatedAt().String(),
+			}
+
+			if includeCounts {
+				memberCount, teamCount, err := countExternalGroupMembersAndTeams(ctx, client, orgName, group.GetGroupID())
+				if err != nil {
+					return err
+				}
+				groupState["member_count"] = memberCount
+				groupState["team_count"] = teamCount
+			}
+
+			groupsState = append(groupsState, groupState)
+		}
 
 		if resp.NextPage == 0 {
 			break
@@ -64,18 +106,6 @@ func dataSourceGithubExternalGroupsRead(d *schema.ResourceData, meta interface{}
 		opts.Page = resp.NextPage
 	}
 
-	// convert to JSON in order to martial to format we can return
-	jsonGroups, err := json.Marshal(externalGroups.Groups)
-	if err != nil {
-		return err
-	}
-
-	groupsState := make([]map[string]interface{}, 0)
-	err = json.Unmarshal(jsonGroups, &groupsState)
-	if err != nil {
-		return err
-	}
-
 	if err := d.Set("external_groups", groupsState); err != nil {
 		return err
 	}
@@ -83,3 +113,31 @@ func dataSourceGithubExternalGroupsRead(d *schema.ResourceData, meta interface{}
 	d.SetId(fmt.Sprintf("/orgs/%v/external-groups", orgName))
 	return nil
 }
+
+// countExternalGroupMembersAndTeams gets a single external group, as the
+// members and teams of the groups aren't included when listing them. The
+// members of a group are paginated, while all of its teams are returned
+// with every page.
+func countExternalGroupMembersAndTeams(ctx context.Context, client *github.Client, orgName string, groupID int64) (int, int, error) {
+	memberCount := 0
+	page := 1
+	for {
+		u := fmt.Sprintf("orgs/%s/external-group/%d?page=%d", orgName, groupID, page)
+		req, err := client.NewRequest("GET", u, nil)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		group := new(github.ExternalGroup)
+		resp, err := client.Do(ctx, req, group)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		memberCount += len(group.Members)
+		if resp.NextPage == 0 {
+			return memberCount, len(group.Teams), nil
+		}
+		page = resp.NextPage
+	}
+}
@@ -0,0 +1,65 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceGithubExternalGroups(t *testing.T) {
+	t.Run("lists the groups matching the display name with their counts", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/external-groups?display_name=eng&per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"groups": [{"group_id": 1, "group_name": "Engineering", "updated_at": "2024-01-01T00:00:00Z"}]}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/external-group/1?page=1",
+				ExpectedMethod: "GET",
+				ResponseHeaders: map[string]string{
+					"Link": `<https://api.github.com/orgs/example/external-group/1?page=2>; rel="next"`,
+				},
+				ResponseBody: `{"group_id": 1, "teams": [{"team_id": 10}], "members": [{"member_id": 100}, {"member_id": 101}]}`,
+				StatusCode:   http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/external-group/1?page=2",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"group_id": 1, "teams": [{"team_id": 10}], "members": [{"member_id": 102}]}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, dataSourceGithubExternalGroups().Schema, map[string]interface{}{
+			"display_name":   "eng",
+			"include_counts": true,
+		})
+
+		err := dataSourceGithubExternalGroupsRead(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if v := d.Get("external_groups.#").(int); v != 1 {
+			t.Fatalf("Expected 1 group, got %d", v)
+		}
+		if v := d.Get("external_groups.0.member_count").(int); v != 3 {
+			t.Errorf("Expected 3 members, got %d", v)
+		}
+		if v := d.Get("external_groups.0.team_count").(int); v != 1 {
+			t.Errorf("Expected 1 team, got %d", v)
+		}
+	})
+}
@@ -0,0 +1,206 @@
+package github
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGithubIssuesSearch() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubIssuesSearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The issue search query, including qualifiers such as 'org:', 'is:issue' or 'label:'.",
+			},
+			"sort": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+					"comments", "reactions", "reactions-+1", "reactions--1", "reactions-smile",
+					"reactions-thinking_face", "reactions-heart", "reactions-tada", "interactions", "created", "updated",
+				}, false), "sort"),
+				Description: "The field to sort the results by. Defaults to best match.",
+			},
+			"order": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "desc",
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"asc", "desc"}, false), "order"),
+			},
+			"results_per_page": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				ValidateDiagFunc: toDiagFunc(validation.IntBetween(1, 100), "results_per_page"),
+			},
+			"max_results": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1000,
+				ValidateDiagFunc: toDiagFunc(validation.IntBetween(0, 1000), "max_results"),
+				Description:      "The maximum number of results to fetch. Set to 0 to only fetch 'total_count'.",
+			},
+			"total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"incomplete_results": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"issues": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_pull_request": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"author": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubIssuesSearchRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+
+	query := d.Get("query").(string)
+	maxResults := d.Get("max_results").(int)
+	opt := &github.SearchOptions{
+		Sort:  d.Get("sort").(string),
+		Order: d.Get("order").(string),
+		ListOptions: github.ListOptions{
+			PerPage: searchResultsPerPage(d.Get("results_per_page").(int), maxResults),
+		},
+	}
+
+	issues := make([]interface{}, 0)
+	var totalCount int
+	var incompleteResults bool
+
+	for {
+		page, resp, err := client.Search.Issues(context.Background(), query, opt)
+		if err != nil {
+			return err
+		}
+
+		totalCount = page.GetTotal()
+		incompleteResults = incompleteResults || page.GetIncompleteResults()
+
+		for _, issue := range page.Issues {
+			if len(issues) >= maxResults {
+				break
+			}
+			issues = append(issues, flattenIssueSearchResult(issue))
+		}
+
+		if resp.NextPage == 0 || len(issues) >= maxResults {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	d.SetId(query)
+	err := d.Set("total_count", totalCount)
+	if err != nil {
+		return err
+	}
+	err = d.Set("incomplete_results", incompleteResults)
+	if err != nil {
+		return err
+	}
+	err = d.Set("issues", issues)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// searchResultsPerPage avoids fetching more results than needed. When only
+// the total count is wanted, a single result is still requested since the
+// count comes with every page.
+func searchResultsPerPage(resultsPerPage, maxResults int) int {
+	if maxResults < 1 {
+		return 1
+	}
+	if maxResults < resultsPerPage {
+		return maxResults
+	}
+	return resultsPerPage
+}
+
+func flattenIssueSearchResult(issue *github.Issue) map[string]interface{} {
+	labels := make([]string, 0, len(issue.Labels))
+	for _, label := range issue.Labels {
+		labels = append(labels, label.GetName())
+	}
+
+	// Search results don't embed the repository, only its API URL.
+	repository := issue.GetRepository().GetFullName()
+	if repository == "" {
+		if i := strings.LastIndex(issue.GetRepositoryURL(), "/repos/"); i >= 0 {
+			repository = issue.GetRepositoryURL()[i+len("/repos/"):]
+		}
+	}
+
+	return map[string]interface{}{
+		"repository":      repository,
+		"number":          issue.GetNumber(),
+		"title":           issue.GetTitle(),
+		"state":           issue.GetState(),
+		"is_pull_request": issue.IsPullRequest(),
+		"labels":          labels,
+		"author":          issue.GetUser().GetLogin(),
+		"html_url":        issue.GetHTMLURL(),
+		"created_at":      issue.GetCreatedAt().String(),
+		"updated_at":      issue.GetUpdatedAt().String(),
+	}
+}
@@ -0,0 +1,95 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestSearchResultsPerPage(t *testing.T) {
+	for _, tc := range []struct {
+		resultsPerPage, maxResults, expected int
+	}{
+		{100, 1000, 100},
+		{100, 10, 10},
+		{30, 50, 30},
+		{100, 0, 1},
+	} {
+		if actual := searchResultsPerPage(tc.resultsPerPage, tc.maxResults); actual != tc.expected {
+			t.Errorf("Expected %d results per page for results_per_page %d and max_results %d, got %d",
+				tc.expected, tc.resultsPerPage, tc.maxResults, actual)
+		}
+	}
+}
+
+func TestFlattenIssueSearchResult(t *testing.T) {
+	issue := &github.Issue{
+		Number:        github.Int(42),
+		Title:         github.String("Outage"),
+		State:         github.String("open"),
+		RepositoryURL: github.String("https://api.github.com/repos/octo-org/hello-world"),
+		Labels:        []*github.Label{{Name: github.String("incident")}},
+		User:          &github.User{Login: github.String("octocat")},
+		PullRequestLinks: &github.PullRequestLinks{
+			URL: github.String("https://api.github.com/repos/octo-org/hello-world/pulls/42"),
+		},
+	}
+
+	flattened := flattenIssueSearchResult(issue)
+
+	if flattened["repository"] != "octo-org/hello-world" {
+		t.Errorf("Expected repository octo-org/hello-world, got %v", flattened["repository"])
+	}
+	if flattened["is_pull_request"] != true {
+		t.Errorf("Expected a pull request, got %v", flattened["is_pull_request"])
+	}
+	if labels := flattened["labels"].([]string); len(labels) != 1 || labels[0] != "incident" {
+		t.Errorf("Expected label incident, got %v", labels)
+	}
+	if flattened["author"] != "octocat" {
+		t.Errorf("Expected author octocat, got %v", flattened["author"])
+	}
+}
+
+func TestAccGithubIssuesSearchDataSource(t *testing.T) {
+	t.Run("counts issues of an organization without error", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			data "github_issues_search" "test" {
+				query       = "org:%s is:issue"
+				max_results = 0
+			}
+		`, testOrganization)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet("data.github_issues_search.test", "total_count"),
+			resource.TestCheckResourceAttr("data.github_issues_search.test", "issues.#", "0"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -42,6 +42,26 @@ func dataSourceGithubOrganization() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"plan_seats": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"plan_filled_seats": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"plan_private_repositories": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"public_repositories_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"private_repositories_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"repositories": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -144,6 +164,19 @@ func dataSourceGithubOrganization() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"include_advanced_security_usage": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"advanced_security_committers": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"advanced_security_purchased_committers": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -273,6 +306,22 @@ func dataSourceGithubOrganizationRead(d *schema.ResourceData, meta interface{})
 	d.Set("node_id", organization.GetNodeID())
 	d.Set("description", organization.GetDescription())
 	d.Set("plan", planName)
+	d.Set("plan_seats", organization.GetPlan().GetSeats())
+	d.Set("plan_filled_seats", organization.GetPlan().GetFilledSeats())
+	d.Set("plan_private_repositories", organization.GetPlan().GetPrivateRepos())
+	d.Set("public_repositories_count", organization.GetPublicRepos())
+	d.Set("private_repositories_count", organization.GetTotalPrivateRepos())
+
+	// Reading the advanced security usage requires access to the billing of
+	// the organization, so it is only read when asked for.
+	if d.Get("include_advanced_security_usage").(bool) {
+		committers, _, err := client3.Billing.GetAdvancedSecurityActiveCommittersOrg(ctx, name, &github.ListOptions{PerPage: 1})
+		if err != nil {
+			return err
+		}
+		d.Set("advanced_security_committers", committers.TotalAdvancedSecurityCommitters)
+		d.Set("advanced_security_purchased_committers", committers.PurchasedAdvancedSecurityCommitters)
+	}
 
 	return nil
 }
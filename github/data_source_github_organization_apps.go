@@ -0,0 +1,127 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubOrganizationApps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationAppsRead,
+
+		Schema: map[string]*schema.Schema{
+			"installations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"app_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"app_slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repository_selection": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permissions": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"events": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"suspended": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationAppsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	owner := meta.(*Owner).name
+
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+
+	options := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+
+	results := make([]map[string]interface{}, 0)
+	for {
+		installations, resp, err := client.Organizations.ListInstallations(ctx, owner, options)
+		if err != nil {
+			return err
+		}
+
+		for _, installation := range installations.Installations {
+			permissions, err := flattenInstallationPermissions(installation.GetPermissions())
+			if err != nil {
+				return err
+			}
+
+			results = append(results, map[string]interface{}{
+				"id":                   installation.GetID(),
+				"app_id":               installation.GetAppID(),
+				"app_slug":             installation.GetAppSlug(),
+				"repository_selection": installation.GetRepositorySelection(),
+				"permissions":          permissions,
+				"events":               installation.Events,
+				"suspended":            installation.SuspendedAt != nil,
+			})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(owner)
+	err = d.Set("installations", results)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// flattenInstallationPermissions converts the permissions granted to an app
+// installation into a map of permission name to access level.
+func flattenInstallationPermissions(permissions *github.InstallationPermissions) (map[string]interface{}, error) {
+	bytes, err := json.Marshal(permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]interface{})
+	err = json.Unmarshal(bytes, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
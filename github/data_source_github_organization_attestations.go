@@ -0,0 +1,140 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The attestation endpoints are not supported by go-github yet, so requests
+// are made with the generic client.
+
+type organizationAttestations struct {
+	Attestations []*organizationAttestation `json:"attestations"`
+}
+
+type organizationAttestation struct {
+	RepositoryID int64           `json:"repository_id"`
+	Bundle       json.RawMessage `json:"bundle"`
+}
+
+type attestationBundle struct {
+	MediaType            string          `json:"mediaType"`
+	VerificationMaterial json.RawMessage `json:"verificationMaterial"`
+}
+
+func dataSourceGithubOrganizationAttestations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationAttestationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"subject_digest": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The digest of the attestation subject, in the form 'sha256:HEX_DIGEST'.",
+			},
+			"predicate_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return attestations with this predicate type, e.g. 'provenance' or 'sbom'.",
+			},
+			"attestations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bundle": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bundle_media_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"verification_material": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationAttestationsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	subjectDigest := d.Get("subject_digest").(string)
+
+	params := url.Values{}
+	params.Set("per_page", fmt.Sprint(maxPerPage))
+	if predicateType, ok := d.GetOk("predicate_type"); ok {
+		params.Set("predicate_type", predicateType.(string))
+	}
+
+	attestations := make([]interface{}, 0)
+	for {
+		u := fmt.Sprintf("orgs/%s/attestations/%s?%s", orgName, url.PathEscape(subjectDigest), params.Encode())
+		req, err := client.NewRequest("GET", u, nil)
+		if err != nil {
+			return err
+		}
+
+		page := new(organizationAttestations)
+		resp, err := client.Do(ctx, req, page)
+		if err != nil {
+			return err
+		}
+
+		for _, attestation := range page.Attestations {
+			flattened, err := flattenOrganizationAttestation(attestation)
+			if err != nil {
+				return err
+			}
+			attestations = append(attestations, flattened)
+		}
+
+		if resp.After == "" {
+			break
+		}
+		params.Set("after", resp.After)
+	}
+
+	d.SetId(buildTwoPartID(orgName, subjectDigest))
+	return d.Set("attestations", attestations)
+}
+
+func flattenOrganizationAttestation(attestation *organizationAttestation) (map[string]interface{}, error) {
+	bundle := new(attestationBundle)
+	err := json.Unmarshal(attestation.Bundle, bundle)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing attestation bundle: %s", err)
+	}
+
+	verificationMaterial := ""
+	if len(bundle.VerificationMaterial) > 0 {
+		verificationMaterial = string(bundle.VerificationMaterial)
+	}
+
+	return map[string]interface{}{
+		"repository_id":         attestation.RepositoryID,
+		"bundle":                string(attestation.Bundle),
+		"bundle_media_type":     bundle.MediaType,
+		"verification_material": verificationMaterial,
+	}, nil
+}
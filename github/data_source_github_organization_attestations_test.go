@@ -0,0 +1,60 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceGithubOrganizationAttestationsRead(t *testing.T) {
+	bundle := `{"mediaType":"application/vnd.dev.sigstore.bundle.v0.3+json","verificationMaterial":{"certificate":{"rawBytes":"MIIC"}},"dsseEnvelope":{}}`
+
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/orgs/example/attestations/sha256:abc?per_page=100&predicate_type=provenance",
+			ResponseHeaders: map[string]string{
+				"Link": `</orgs/example/attestations/sha256:abc?after=Y3Vyc29y&per_page=100&predicate_type=provenance>; rel="next"`,
+			},
+			ResponseBody: `{"attestations": [{"repository_id": 1, "bundle": ` + bundle + `}]}`,
+			StatusCode:   http.StatusOK,
+		},
+		{
+			ExpectedUri:  "/orgs/example/attestations/sha256:abc?after=Y3Vyc29y&per_page=100&predicate_type=provenance",
+			ResponseBody: `{"attestations": [{"repository_id": 2, "bundle": {"mediaType": "application/vnd.dev.sigstore.bundle+json;version=0.2"}}]}`,
+			StatusCode:   http.StatusOK,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubOrganizationAttestations().Schema, map[string]interface{}{
+		"subject_digest": "sha256:abc",
+		"predicate_type": "provenance",
+	})
+
+	err := dataSourceGithubOrganizationAttestationsRead(d, meta)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	if count := d.Get("attestations.#").(int); count != 2 {
+		t.Fatalf("Expected 2 attestations, got %d", count)
+	}
+	if id := d.Get("attestations.1.repository_id").(int); id != 2 {
+		t.Errorf("Expected the second attestation to come from repository 2, got %d", id)
+	}
+	if material := d.Get("attestations.0.verification_material").(string); material != `{"certificate":{"rawBytes":"MIIC"}}` {
+		t.Errorf("Expected the verification material of the bundle, got %q", material)
+	}
+	if mediaType := d.Get("attestations.1.bundle_media_type").(string); mediaType != "application/vnd.dev.sigstore.bundle+json;version=0.2" {
+		t.Errorf("Expected the media type of the bundle, got %q", mediaType)
+	}
+}
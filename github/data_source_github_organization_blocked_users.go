@@ -0,0 +1,72 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubOrganizationBlockedUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationBlockedUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"login": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationBlockedUsersRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	options := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+
+	users := make([]interface{}, 0)
+	for {
+		blockedUsers, resp, err := client.Organizations.ListBlockedUsers(ctx, orgName, options)
+		if err != nil {
+			return err
+		}
+
+		for _, user := range blockedUsers {
+			users = append(users, map[string]interface{}{
+				"id":    user.GetID(),
+				"login": user.GetLogin(),
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(orgName)
+
+	return d.Set("users", users)
+}
@@ -0,0 +1,49 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceGithubOrganizationBlockedUsers(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:     "/orgs/example/blocks?per_page=100",
+			ExpectedMethod:  "GET",
+			ResponseHeaders: map[string]string{"Link": `<https://api.github.com/orgs/example/blocks?per_page=100&page=2>; rel="next"`},
+			ResponseBody:    `[{"id": 1, "login": "spammer"}]`,
+			StatusCode:      http.StatusOK,
+		},
+		{
+			ExpectedUri:    "/orgs/example/blocks?page=2&per_page=100",
+			ExpectedMethod: "GET",
+			ResponseBody:   `[{"id": 2, "login": "troll"}]`,
+			StatusCode:     http.StatusOK,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubOrganizationBlockedUsers().Schema, map[string]interface{}{})
+
+	err := dataSourceGithubOrganizationBlockedUsersRead(d, meta)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	if n := d.Get("users.#").(int); n != 2 {
+		t.Fatalf("Expected 2 users, got %d", n)
+	}
+	if login := d.Get("users.1.login").(string); login != "troll" {
+		t.Errorf("Unexpected login %q", login)
+	}
+}
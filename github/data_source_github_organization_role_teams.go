@@ -0,0 +1,94 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubOrganizationRoleTeams() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationRoleTeamsRead,
+
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the organization role.",
+			},
+			"teams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationRoleTeamsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	owner := meta.(*Owner).name
+	roleID := int64(d.Get("role_id").(int))
+
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+
+	options := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+
+	results := make([]map[string]interface{}, 0)
+	for {
+		teams, resp, err := client.Organizations.ListTeamsAssignedToOrgRole(ctx, owner, roleID, options)
+		if err != nil {
+			return fmt.Errorf("error querying teams assigned to GitHub organization role %s (%d): %s", owner, roleID, err)
+		}
+
+		for _, team := range teams {
+			results = append(results, map[string]interface{}{
+				"id":         team.GetID(),
+				"slug":       team.GetSlug(),
+				"name":       team.GetName(),
+				"permission": team.GetPermission(),
+			})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprint(roleID))
+	err = d.Set("teams", results)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
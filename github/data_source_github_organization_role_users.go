@@ -0,0 +1,84 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubOrganizationRoleUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationRoleUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the organization role.",
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"login": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationRoleUsersRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	owner := meta.(*Owner).name
+	roleID := int64(d.Get("role_id").(int))
+
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+
+	options := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+
+	results := make([]map[string]interface{}, 0)
+	for {
+		users, resp, err := client.Organizations.ListUsersAssignedToOrgRole(ctx, owner, roleID, options)
+		if err != nil {
+			return fmt.Errorf("error querying users assigned to GitHub organization role %s (%d): %s", owner, roleID, err)
+		}
+
+		for _, user := range users {
+			results = append(results, map[string]interface{}{
+				"id":    user.GetID(),
+				"login": user.GetLogin(),
+			})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprint(roleID))
+	err = d.Set("users", results)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
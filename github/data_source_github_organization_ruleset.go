@@ -0,0 +1,53 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubOrganizationRuleset() *schema.Resource {
+	s := computedSchemaFromResourceSchema(resourceGithubOrganizationRuleset().Schema)
+	s["ruleset_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Required:    true,
+		Description: "GitHub ID for the ruleset.",
+	}
+
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationRulesetRead,
+
+		Schema: s,
+	}
+}
+
+func dataSourceGithubOrganizationRulesetRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+	owner := meta.(*Owner).name
+	rulesetID := int64(d.Get("ruleset_id").(int))
+
+	ruleset, _, _, resp, err := getRulesetJSON(ctx, client, fmt.Sprintf("orgs/%s/rulesets/%d", owner, rulesetID))
+	if err != nil {
+		return fmt.Errorf("error querying GitHub organization ruleset %s/%d: %s", owner, rulesetID, err)
+	}
+
+	d.SetId(strconv.FormatInt(ruleset.GetID(), 10))
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("name", ruleset.Name)
+	d.Set("target", ruleset.GetTarget())
+	d.Set("enforcement", ruleset.Enforcement)
+	d.Set("bypass_actors", flattenBypassActors(ruleset.BypassActors))
+	d.Set("conditions", flattenConditions(ruleset.GetConditions(), true))
+	d.Set("rules", flattenRules(ruleset.Rules, true))
+	d.Set("node_id", ruleset.GetNodeID())
+
+	return nil
+}
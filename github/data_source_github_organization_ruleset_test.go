@@ -0,0 +1,97 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationRulesetDataSource(t *testing.T) {
+
+	t.Run("queries an organization ruleset by ID", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+		config := fmt.Sprintf(`
+			resource "github_organization_ruleset" "test" {
+				name        = "tf-acc-test-%s"
+				target      = "branch"
+				enforcement = "active"
+
+				conditions {
+					ref_name {
+						include = ["~ALL"]
+						exclude = []
+					}
+				}
+
+				rules {
+					creation = true
+
+					pull_request {
+						required_approving_review_count = 1
+					}
+				}
+			}
+		`, randomID)
+
+		config2 := config + `
+			data "github_organization_ruleset" "test" {
+				ruleset_id = github_organization_ruleset.test.ruleset_id
+			}
+		`
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(
+				"data.github_organization_ruleset.test", "name",
+				fmt.Sprintf(`tf-acc-test-%s`, randomID),
+			),
+			resource.TestCheckResourceAttr(
+				"data.github_organization_ruleset.test", "enforcement",
+				"active",
+			),
+			resource.TestCheckResourceAttr(
+				"data.github_organization_ruleset.test", "conditions.0.ref_name.0.include.0",
+				"~ALL",
+			),
+			resource.TestCheckResourceAttr(
+				"data.github_organization_ruleset.test", "rules.0.creation",
+				"true",
+			),
+			resource.TestCheckResourceAttr(
+				"data.github_organization_ruleset.test", "rules.0.pull_request.0.required_approving_review_count",
+				"1",
+			),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  resource.ComposeTestCheckFunc(),
+					},
+					{
+						Config: config2,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -25,6 +25,8 @@ func TestAccGithubOrganizationDataSource(t *testing.T) {
 			resource.TestCheckResourceAttrSet("data.github_organization.test", "node_id"),
 			resource.TestCheckResourceAttrSet("data.github_organization.test", "description"),
 			resource.TestCheckResourceAttrSet("data.github_organization.test", "plan"),
+			resource.TestCheckResourceAttrSet("data.github_organization.test", "public_repositories_count"),
+			resource.TestCheckResourceAttrSet("data.github_organization.test", "private_repositories_count"),
 			resource.TestCheckResourceAttrSet("data.github_organization.test", "repositories.#"),
 			resource.TestCheckResourceAttrSet("data.github_organization.test", "members.#"),
 			resource.TestCheckResourceAttrSet("data.github_organization.test", "two_factor_requirement_enabled"),
@@ -154,6 +156,8 @@ func TestAccGithubOrganizationDataSource(t *testing.T) {
 			resource.TestCheckResourceAttrSet("data.github_organization.test", "node_id"),
 			resource.TestCheckResourceAttrSet("data.github_organization.test", "description"),
 			resource.TestCheckResourceAttrSet("data.github_organization.test", "plan"),
+			resource.TestCheckResourceAttrSet("data.github_organization.test", "public_repositories_count"),
+			resource.TestCheckResourceAttrSet("data.github_organization.test", "private_repositories_count"),
 			resource.TestCheckNoResourceAttr("data.github_organization.test", "repositories.#"),
 			resource.TestCheckNoResourceAttr("data.github_organization.test", "members.#"),
 			resource.TestCheckNoResourceAttr("data.github_organization.test", "two_factor_requirement_enabled"),
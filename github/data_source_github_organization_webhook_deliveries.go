@@ -0,0 +1,130 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGithubOrganizationWebhookDeliveries() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationWebhookDeliveriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"webhook_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the organization webhook.",
+			},
+			"max_results": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				ValidateDiagFunc: toDiagFunc(validation.IntAtLeast(1), "max_results"),
+				Description:      "The maximum number of deliveries to return, most recent first.",
+			},
+			"deliveries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"guid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delivered_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"redelivery": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"duration": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_code": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"event": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationWebhookDeliveriesRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	hookID := int64(d.Get("webhook_id").(int))
+	maxResults := d.Get("max_results").(int)
+
+	options := &github.ListCursorOptions{
+		PerPage: min(maxResults, maxPerPage),
+	}
+
+	deliveries := make([]interface{}, 0)
+	for len(deliveries) < maxResults {
+		page, resp, err := client.Organizations.ListHookDeliveries(ctx, orgName, hookID, options)
+		if err != nil {
+			return err
+		}
+
+		for _, delivery := range page {
+			if len(deliveries) == maxResults {
+				break
+			}
+			deliveries = append(deliveries, flattenHookDelivery(delivery))
+		}
+
+		if resp.Cursor == "" {
+			break
+		}
+		options.Cursor = resp.Cursor
+	}
+
+	d.SetId(fmt.Sprint(hookID))
+	return d.Set("deliveries", deliveries)
+}
+
+func flattenHookDelivery(delivery *github.HookDelivery) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           delivery.GetID(),
+		"guid":         delivery.GetGUID(),
+		"delivered_at": delivery.GetDeliveredAt().String(),
+		"redelivery":   delivery.GetRedelivery(),
+		"duration":     delivery.GetDuration(),
+		"status":       delivery.GetStatus(),
+		"status_code":  delivery.GetStatusCode(),
+		"event":        delivery.GetEvent(),
+		"action":       delivery.GetAction(),
+	}
+}
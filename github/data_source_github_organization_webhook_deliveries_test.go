@@ -0,0 +1,61 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceGithubOrganizationWebhookDeliveriesRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/orgs/example/hooks/42/deliveries?per_page=3",
+			ResponseHeaders: map[string]string{
+				"Link": `</orgs/example/hooks/42/deliveries?cursor=v1_2&per_page=3>; rel="next"`,
+			},
+			ResponseBody: `[
+				{"id": 3, "guid": "c", "status": "OK", "status_code": 200, "event": "push", "duration": 0.5},
+				{"id": 2, "guid": "b", "status": "Invalid HTTP Response: 500", "status_code": 500, "event": "issues", "action": "opened", "redelivery": true}
+			]`,
+			StatusCode: http.StatusOK,
+		},
+		{
+			ExpectedUri:  "/orgs/example/hooks/42/deliveries?cursor=v1_2&per_page=3",
+			ResponseBody: `[{"id": 1, "guid": "a"}, {"id": 0, "guid": "z"}]`,
+			StatusCode:   http.StatusOK,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubOrganizationWebhookDeliveries().Schema, map[string]interface{}{
+		"webhook_id":  42,
+		"max_results": 3,
+	})
+
+	err := dataSourceGithubOrganizationWebhookDeliveriesRead(d, meta)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	if count := d.Get("deliveries.#").(int); count != 3 {
+		t.Fatalf("Expected max_results to limit the deliveries to 3, got %d", count)
+	}
+	if code := d.Get("deliveries.1.status_code").(int); code != 500 {
+		t.Errorf("Expected status code 500, got %d", code)
+	}
+	if redelivery := d.Get("deliveries.1.redelivery").(bool); !redelivery {
+		t.Errorf("Expected the second delivery to be a redelivery")
+	}
+	if guid := d.Get("deliveries.2.guid").(string); guid != "a" {
+		t.Errorf("Expected the third delivery to come from the second page, got %q", guid)
+	}
+}
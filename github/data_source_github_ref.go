@@ -2,11 +2,13 @@ package github
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 
 	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
 )
 
 func dataSourceGithubRef() *schema.Resource {
@@ -15,9 +17,17 @@ func dataSourceGithubRef() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"ref": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ref", "expression"},
+			},
+			"expression": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ref", "expression"},
+				Description:  "A Git revision expression, such as a branch or tag name or 'main~2', to resolve to a commit.",
 			},
 			"repository": {
 				Type:     schema.TypeString,
@@ -42,11 +52,16 @@ func dataSourceGithubRef() *schema.Resource {
 
 func dataSourceGithubRefRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Owner).v3client
-	owner, ok := d.Get("owner").(string)
-	if !ok {
+	owner := d.Get("owner").(string)
+	if owner == "" {
 		owner = meta.(*Owner).name
 	}
 	repoName := d.Get("repository").(string)
+
+	if expression, ok := d.GetOk("expression"); ok {
+		return dataSourceGithubRefReadExpression(d, meta, owner, repoName, expression.(string))
+	}
+
 	ref := d.Get("ref").(string)
 
 	refData, resp, err := client.Git.GetRef(context.TODO(), owner, repoName, ref)
@@ -73,3 +88,53 @@ func dataSourceGithubRefRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// dataSourceGithubRefReadExpression resolves a revision expression to the SHA
+// of the commit it points at, peeling annotated tags. Unlike ref lookups, an
+// expression that does not resolve to a commit is an error.
+func dataSourceGithubRefReadExpression(d *schema.ResourceData, meta interface{}, owner, repoName, expression string) error {
+	client := meta.(*Owner).v4client
+
+	var query struct {
+		Repository struct {
+			Object struct {
+				Typename githubv4.String `graphql:"__typename"`
+				Commit   struct {
+					Oid githubv4.String
+				} `graphql:"... on Commit"`
+				Tag struct {
+					Target struct {
+						Typename githubv4.String `graphql:"__typename"`
+						Oid      githubv4.String
+					}
+				} `graphql:"... on Tag"`
+			} `graphql:"object(expression: $expression)"`
+		} `graphql:"repository(name: $name, owner: $owner)"`
+	}
+	variables := map[string]interface{}{
+		"expression": githubv4.String(expression),
+		"name":       githubv4.String(repoName),
+		"owner":      githubv4.String(owner),
+	}
+
+	err := client.Query(meta.(*Owner).StopContext, &query, variables)
+	if err != nil {
+		return err
+	}
+
+	var sha githubv4.String
+	object := query.Repository.Object
+	switch {
+	case object.Typename == "Commit":
+		sha = object.Commit.Oid
+	case object.Typename == "Tag" && object.Tag.Target.Typename == "Commit":
+		sha = object.Tag.Target.Oid
+	case object.Typename == "":
+		return fmt.Errorf("expression %q does not exist in repository %s/%s", expression, owner, repoName)
+	default:
+		return fmt.Errorf("expression %q in repository %s/%s does not resolve to a commit", expression, owner, repoName)
+	}
+
+	d.SetId(buildTwoPartID(repoName, expression))
+	return d.Set("sha", string(sha))
+}
@@ -0,0 +1,145 @@
+package github
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubRepositoriesByCustomProperty() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRepositoriesByCustomPropertyRead,
+
+		Schema: map[string]*schema.Schema{
+			"properties": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Custom property values the repositories must all have, keyed by property name.",
+			},
+			"full_names": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"names": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"repo_ids": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubRepositoriesByCustomPropertyRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	filter := make(map[string]string)
+	for name, value := range d.Get("properties").(map[string]interface{}) {
+		filter[name] = value.(string)
+	}
+
+	options := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+
+	var repos []*github.RepoCustomPropertyValue
+	for {
+		page, resp, err := client.Organizations.ListCustomPropertyValues(ctx, orgName, options)
+		if err != nil {
+			return err
+		}
+
+		for _, repo := range page {
+			if customPropertiesMatch(repo.Properties, filter) {
+				repos = append(repos, repo)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	sort.Slice(repos, func(i, j int) bool {
+		return repos[i].RepositoryName < repos[j].RepositoryName
+	})
+
+	fullNames := make([]string, 0, len(repos))
+	names := make([]string, 0, len(repos))
+	repoIDs := make([]int64, 0, len(repos))
+	for _, repo := range repos {
+		fullNames = append(fullNames, repo.RepositoryFullName)
+		names = append(names, repo.RepositoryName)
+		repoIDs = append(repoIDs, repo.RepositoryID)
+	}
+
+	log.Printf("[DEBUG] Found %d repositories in %s matching custom properties %v", len(repos), orgName, filter)
+
+	d.SetId(buildTwoPartID(orgName, "custom-properties"))
+	err = d.Set("full_names", fullNames)
+	if err != nil {
+		return err
+	}
+	err = d.Set("names", names)
+	if err != nil {
+		return err
+	}
+	return d.Set("repo_ids", repoIDs)
+}
+
+// customPropertiesMatch reports whether every property in filter is set to the
+// expected value. For multi select properties it is enough for the expected
+// value to be one of the selected values.
+func customPropertiesMatch(properties []*github.CustomPropertyValue, filter map[string]string) bool {
+	values := make(map[string]interface{}, len(properties))
+	for _, property := range properties {
+		values[property.PropertyName] = property.Value
+	}
+
+	for name, expected := range filter {
+		switch v := values[name].(type) {
+		case string:
+			if v != expected {
+				return false
+			}
+		case []string:
+			found := false
+			for _, value := range v {
+				if value == expected {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,38 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+)
+
+func TestCustomPropertiesMatch(t *testing.T) {
+	properties := []*github.CustomPropertyValue{
+		{PropertyName: "team", Value: "payments"},
+		{PropertyName: "languages", Value: []string{"go", "typescript"}},
+		{PropertyName: "owner", Value: nil},
+	}
+
+	cases := []struct {
+		name     string
+		filter   map[string]string
+		expected bool
+	}{
+		{"matching string", map[string]string{"team": "payments"}, true},
+		{"different string", map[string]string{"team": "billing"}, false},
+		{"selected multi select value", map[string]string{"languages": "go"}, true},
+		{"unselected multi select value", map[string]string{"languages": "rust"}, false},
+		{"unset property", map[string]string{"owner": "payments"}, false},
+		{"unknown property", map[string]string{"unknown": "payments"}, false},
+		{"all properties match", map[string]string{"team": "payments", "languages": "typescript"}, true},
+		{"one property mismatches", map[string]string{"team": "payments", "languages": "rust"}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := customPropertiesMatch(properties, c.filter); actual != c.expected {
+				t.Fatalf("Expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
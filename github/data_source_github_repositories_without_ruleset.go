@@ -0,0 +1,139 @@
+package github
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubRepositoriesWithoutRuleset() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRepositoriesWithoutRulesetRead,
+
+		Schema: map[string]*schema.Schema{
+			"ruleset_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the ruleset the repositories must not have.",
+			},
+			"include_parents": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether rulesets configured at the organization level that apply to a repository are taken into account.",
+			},
+			"include_archived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether archived repositories are included.",
+			},
+			"full_names": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"names": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"repo_ids": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubRepositoriesWithoutRulesetRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	rulesetName := d.Get("ruleset_name").(string)
+	includeParents := d.Get("include_parents").(bool)
+	includeArchived := d.Get("include_archived").(bool)
+
+	options := &github.RepositoryListByOrgOptions{
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+
+	var repos []*github.Repository
+	for {
+		page, resp, err := client.Repositories.ListByOrg(ctx, orgName, options)
+		if err != nil {
+			return err
+		}
+
+		for _, repo := range page {
+			if repo.GetArchived() && !includeArchived {
+				continue
+			}
+
+			rulesets, _, err := client.Repositories.GetAllRulesets(ctx, orgName, repo.GetName(), includeParents)
+			if err != nil {
+				return err
+			}
+			if !rulesetsContainName(rulesets, rulesetName) {
+				repos = append(repos, repo)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	sort.Slice(repos, func(i, j int) bool {
+		return repos[i].GetName() < repos[j].GetName()
+	})
+
+	fullNames := make([]string, 0, len(repos))
+	names := make([]string, 0, len(repos))
+	repoIDs := make([]int64, 0, len(repos))
+	for _, repo := range repos {
+		fullNames = append(fullNames, repo.GetFullName())
+		names = append(names, repo.GetName())
+		repoIDs = append(repoIDs, repo.GetID())
+	}
+
+	log.Printf("[DEBUG] Found %d repositories in %s without ruleset %q", len(repos), orgName, rulesetName)
+
+	d.SetId(buildTwoPartID(orgName, rulesetName))
+	err = d.Set("full_names", fullNames)
+	if err != nil {
+		return err
+	}
+	err = d.Set("names", names)
+	if err != nil {
+		return err
+	}
+	return d.Set("repo_ids", repoIDs)
+}
+
+// rulesetsContainName reports whether one of the rulesets is named name.
+func rulesetsContainName(rulesets []*github.Ruleset, name string) bool {
+	for _, ruleset := range rulesets {
+		if ruleset.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceGithubRepositoriesWithoutRulesetRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/orgs/example/repos?per_page=100",
+			ResponseBody: `[{"id": 1, "name": "zebra", "full_name": "example/zebra"}, {"id": 2, "name": "legacy", "full_name": "example/legacy", "archived": true}, {"id": 3, "name": "apple", "full_name": "example/apple"}, {"id": 4, "name": "covered", "full_name": "example/covered"}]`,
+			StatusCode:   http.StatusOK,
+		},
+		{
+			ExpectedUri:  "/repos/example/zebra/rulesets?includes_parents=false",
+			ResponseBody: `[{"id": 10, "name": "other"}]`,
+			StatusCode:   http.StatusOK,
+		},
+		{
+			ExpectedUri:  "/repos/example/apple/rulesets?includes_parents=false",
+			ResponseBody: `[]`,
+			StatusCode:   http.StatusOK,
+		},
+		{
+			ExpectedUri:  "/repos/example/covered/rulesets?includes_parents=false",
+			ResponseBody: `[{"id": 11, "name": "baseline"}]`,
+			StatusCode:   http.StatusOK,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubRepositoriesWithoutRuleset().Schema, map[string]interface{}{
+		"ruleset_name": "baseline",
+	})
+
+	err := dataSourceGithubRepositoriesWithoutRulesetRead(d, meta)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	names := d.Get("names").([]interface{})
+	if len(names) != 2 || names[0] != "apple" || names[1] != "zebra" {
+		t.Fatalf("Expected repositories apple and zebra, got %v", names)
+	}
+	if fullName := d.Get("full_names.0").(string); fullName != "example/apple" {
+		t.Errorf("Unexpected full name %q", fullName)
+	}
+	if id := d.Get("repo_ids.1").(int); id != 1 {
+		t.Errorf("Expected the ID of zebra to be 1, got %d", id)
+	}
+}
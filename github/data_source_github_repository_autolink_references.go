@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +22,10 @@ func dataSourceGithubRepositoryAutolinkReferences() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"key_prefix": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -47,7 +52,7 @@ func dataSourceGithubRepositoryAutolinkReferencesRead(d *schema.ResourceData, me
 
 	results := make([]map[string]interface{}, 0)
 
-	var listOptions *github.ListOptions
+	listOptions := &github.ListOptions{PerPage: maxPerPage}
 	for {
 		autoLinks, resp, err := client.Repositories.ListAutolinks(context.Background(), orgName, repoName, listOptions)
 		if err != nil {
@@ -80,6 +85,7 @@ func flattenAutolinkReferences(autoLinks []*github.Autolink) []map[string]interf
 
 	for _, autolink := range autoLinks {
 		linkMap := make(map[string]interface{})
+		linkMap["id"] = strconv.FormatInt(autolink.GetID(), 10)
 		linkMap["key_prefix"] = autolink.GetKeyPrefix()
 		linkMap["target_url_template"] = autolink.GetURLTemplate()
 		linkMap["is_alphanumeric"] = autolink.GetIsAlphanumeric()
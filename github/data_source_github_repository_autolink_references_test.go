@@ -26,6 +26,16 @@ func TestAccGithubRepositoryAutolinkReferencesDataSource(t *testing.T) {
 				key_prefix          = "TEST1-"
 				target_url_template = "https://example.com/TEST-<num>"
 			}
+
+			resource "github_repository_autolink_reference" "autolink_numeric" {
+				repository = github_repository.test.name
+
+				key_prefix          = "TEST2-"
+				target_url_template = "https://example.com/TEST2-<num>"
+				is_alphanumeric     = false
+
+				depends_on = [github_repository_autolink_reference.autolink_default]
+			}
 		`, randomID)
 
 		config2 := config + `
@@ -34,10 +44,13 @@ func TestAccGithubRepositoryAutolinkReferencesDataSource(t *testing.T) {
 			}
 		`
 		check := resource.ComposeTestCheckFunc(
-			resource.TestCheckResourceAttr("data.github_repository_autolink_references.all", "autolink_references.#", "1"),
+			resource.TestCheckResourceAttr("data.github_repository_autolink_references.all", "autolink_references.#", "2"),
+			resource.TestCheckResourceAttrPair("data.github_repository_autolink_references.all", "autolink_references.0.id", "github_repository_autolink_reference.autolink_default", "id"),
 			resource.TestCheckResourceAttr("data.github_repository_autolink_references.all", "autolink_references.0.key_prefix", "TEST1-"),
 			resource.TestCheckResourceAttr("data.github_repository_autolink_references.all", "autolink_references.0.target_url_template", "https://example.com/TEST-<num>"),
 			resource.TestCheckResourceAttr("data.github_repository_autolink_references.all", "autolink_references.0.is_alphanumeric", "true"),
+			resource.TestCheckResourceAttr("data.github_repository_autolink_references.all", "autolink_references.1.key_prefix", "TEST2-"),
+			resource.TestCheckResourceAttr("data.github_repository_autolink_references.all", "autolink_references.1.is_alphanumeric", "false"),
 		)
 
 		testCase := func(t *testing.T, mode string) {
@@ -0,0 +1,160 @@
+package github
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func dataSourceGithubRepositoryCommunityProfile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRepositoryCommunityProfileRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository.",
+			},
+			"health_percentage": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The percentage of the recommended community health files the repository has.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"documentation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the documentation of the repository, if any.",
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_reports_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_readme": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_code_of_conduct": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_contributing": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_issue_template": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_pull_request_template": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_license": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"license": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SPDX ID of the license of the repository, if any.",
+			},
+			"has_codeowners": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_security_policy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"security_policy_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubRepositoryCommunityProfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	ctx := context.Background()
+
+	metrics, _, err := client.Repositories.GetCommunityHealthMetrics(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	// The community profile doesn't cover CODEOWNERS files, whose errors
+	// can only be listed if the repository has one.
+	hasCodeowners := true
+	_, _, err = client.Repositories.GetCodeownersErrors(ctx, owner, repoName, nil)
+	if err != nil {
+		ghErr, ok := err.(*github.ErrorResponse)
+		if !ok || ghErr.Response.StatusCode != http.StatusNotFound {
+			return err
+		}
+		hasCodeowners = false
+	}
+
+	// Nor does it cover security policies, which may also be inherited from
+	// the .github repository of the owner.
+	var query struct {
+		Repository struct {
+			IsSecurityPolicyEnabled githubv4.Boolean
+			SecurityPolicyUrl       githubv4.URI
+		} `graphql:"repository(owner:$owner, name:$name)"`
+	}
+	variables := map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"name":  githubv4.String(repoName),
+	}
+	err = meta.(*Owner).v4client.Query(ctx, &query, variables)
+	if err != nil {
+		return err
+	}
+
+	files := metrics.GetFiles()
+	if files == nil {
+		files = &github.CommunityHealthFiles{}
+	}
+	updatedAt := ""
+	if metrics.UpdatedAt != nil {
+		updatedAt = metrics.UpdatedAt.String()
+	}
+	securityPolicyURL := ""
+	if query.Repository.SecurityPolicyUrl.URL != nil {
+		securityPolicyURL = query.Repository.SecurityPolicyUrl.String()
+	}
+
+	d.SetId(buildTwoPartID(owner, repoName))
+	d.Set("health_percentage", metrics.GetHealthPercentage())
+	d.Set("description", metrics.GetDescription())
+	d.Set("documentation", metrics.GetDocumentation())
+	d.Set("updated_at", updatedAt)
+	d.Set("content_reports_enabled", metrics.GetContentReportsEnabled())
+	d.Set("has_readme", files.Readme != nil)
+	d.Set("has_code_of_conduct", files.CodeOfConduct != nil || files.CodeOfConductFile != nil)
+	d.Set("has_contributing", files.Contributing != nil)
+	d.Set("has_issue_template", files.IssueTemplate != nil)
+	d.Set("has_pull_request_template", files.PullRequestTemplate != nil)
+	d.Set("has_license", files.License != nil)
+	d.Set("license", files.License.GetSPDXID())
+	d.Set("has_codeowners", hasCodeowners)
+	d.Set("has_security_policy", bool(query.Repository.IsSecurityPolicyEnabled))
+	d.Set("security_policy_url", securityPolicyURL)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestDataSourceGithubRepositoryCommunityProfileRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/example/repo/community/profile",
+			ExpectedMethod: "GET",
+			ResponseBody: `{
+				"health_percentage": 71,
+				"files": {
+					"readme": {"url": "https://api.github.com/repos/example/repo/contents/README.md"},
+					"contributing": {"url": "https://api.github.com/repos/example/repo/contents/CONTRIBUTING.md"},
+					"license": {"key": "mit", "spdx_id": "MIT"},
+					"code_of_conduct": null,
+					"issue_template": null,
+					"pull_request_template": null
+				}
+			}`,
+			StatusCode: http.StatusOK,
+		},
+		{
+			ExpectedUri:    "/repos/example/repo/codeowners/errors",
+			ExpectedMethod: "GET",
+			ResponseBody:   `{"message": "Not Found"}`,
+			StatusCode:     http.StatusNotFound,
+		},
+		{
+			ExpectedUri:  "/graphql",
+			ResponseBody: `{"data": {"repository": {"isSecurityPolicyEnabled": true, "securityPolicyUrl": "https://github.com/example/repo/security/policy"}}}`,
+			StatusCode:   http.StatusOK,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	meta := &Owner{
+		name:     "example",
+		v3client: client,
+		v4client: githubv4.NewEnterpriseClient(ts.URL+"/graphql", nil),
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubRepositoryCommunityProfile().Schema, map[string]interface{}{
+		"repository": "repo",
+	})
+
+	err := dataSourceGithubRepositoryCommunityProfileRead(d, meta)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"health_percentage":   71,
+		"has_readme":          true,
+		"has_contributing":    true,
+		"has_code_of_conduct": false,
+		"has_license":         true,
+		"license":             "MIT",
+		"has_codeowners":      false,
+		"has_security_policy": true,
+		"security_policy_url": "https://github.com/example/repo/security/policy",
+	}
+	for k, v := range expected {
+		if got := d.Get(k); got != v {
+			t.Errorf("Expected %s to be %v, got %v", k, v, got)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The rule suite endpoints are not supported by go-github yet, so requests
+// are made with the generic client.
+
+type rulesetRuleSuite struct {
+	ID               int64             `json:"id"`
+	ActorID          int64             `json:"actor_id"`
+	ActorName        string            `json:"actor_name"`
+	BeforeSHA        string            `json:"before_sha"`
+	AfterSHA         string            `json:"after_sha"`
+	Ref              string            `json:"ref"`
+	RepositoryID     int64             `json:"repository_id"`
+	RepositoryName   string            `json:"repository_name"`
+	PushedAt         *github.Timestamp `json:"pushed_at"`
+	Result           string            `json:"result"`
+	EvaluationResult string            `json:"evaluation_result"`
+}
+
+func dataSourceGithubRulesetRuleSuites() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRulesetRuleSuitesRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the repository to list the rule suites of. If omitted, the rule suites of the whole organization are listed.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return rule suites evaluated for this ref, e.g. 'refs/heads/main'.",
+			},
+			"time_period": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "day",
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"hour", "day", "week", "month"}, false), "time_period"),
+				Description:      "The time period to return rule suites for, counting back from now. Can be one of 'hour', 'day', 'week' or 'month'.",
+			},
+			"actor_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return rule suites of pushes by this user.",
+			},
+			"rule_suite_result": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "all",
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"pass", "fail", "bypass", "all"}, false), "rule_suite_result"),
+				Description:      "Only return rule suites with this result. Can be one of 'pass', 'fail', 'bypass' or 'all'.",
+			},
+			"rule_suites": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"actor_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"actor_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"before_sha": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"after_sha": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ref": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repository_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"repository_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pushed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"evaluation_result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubRulesetRuleSuitesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	repoName := d.Get("repository").(string)
+
+	var path string
+	if repoName != "" {
+		path = fmt.Sprintf("repos/%s/%s/rulesets/rule-suites", owner, repoName)
+	} else {
+		err := checkOrganization(meta)
+		if err != nil {
+			return err
+		}
+		path = fmt.Sprintf("orgs/%s/rulesets/rule-suites", owner)
+	}
+
+	params := url.Values{}
+	params.Set("per_page", strconv.Itoa(maxPerPage))
+	params.Set("time_period", d.Get("time_period").(string))
+	params.Set("rule_suite_result", d.Get("rule_suite_result").(string))
+	if ref, ok := d.GetOk("ref"); ok {
+		params.Set("ref", ref.(string))
+	}
+	if actorName, ok := d.GetOk("actor_name"); ok {
+		params.Set("actor_name", actorName.(string))
+	}
+
+	ruleSuites := make([]interface{}, 0)
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("%s?%s", path, params.Encode()), nil)
+		if err != nil {
+			return err
+		}
+
+		var page []*rulesetRuleSuite
+		resp, err := client.Do(ctx, req, &page)
+		if err != nil {
+			return err
+		}
+
+		for _, ruleSuite := range page {
+			ruleSuites = append(ruleSuites, flattenRulesetRuleSuite(ruleSuite))
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		params.Set("page", strconv.Itoa(resp.NextPage))
+	}
+
+	if repoName != "" {
+		d.SetId(buildTwoPartID(owner, repoName))
+	} else {
+		d.SetId(owner)
+	}
+	return d.Set("rule_suites", ruleSuites)
+}
+
+func flattenRulesetRuleSuite(ruleSuite *rulesetRuleSuite) map[string]interface{} {
+	pushedAt := ""
+	if ruleSuite.PushedAt != nil {
+		pushedAt = ruleSuite.PushedAt.String()
+	}
+
+	return map[string]interface{}{
+		"id":                ruleSuite.ID,
+		"actor_id":          ruleSuite.ActorID,
+		"actor_name":        ruleSuite.ActorName,
+		"before_sha":        ruleSuite.BeforeSHA,
+		"after_sha":         ruleSuite.AfterSHA,
+		"ref":               ruleSuite.Ref,
+		"repository_id":     ruleSuite.RepositoryID,
+		"repository_name":   ruleSuite.RepositoryName,
+		"pushed_at":         pushedAt,
+		"result":            ruleSuite.Result,
+		"evaluation_result": ruleSuite.EvaluationResult,
+	}
+}
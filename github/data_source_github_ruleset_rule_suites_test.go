@@ -0,0 +1,100 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceGithubRulesetRuleSuitesRead(t *testing.T) {
+	ruleSuite := `{"id": 21, "actor_id": 12, "actor_name": "octocat", "before_sha": "a", "after_sha": "b", "ref": "refs/heads/main", "repository_id": 404, "repository_name": "hello-world", "pushed_at": "2024-05-01T12:00:00Z", "result": "bypass", "evaluation_result": "fail"}`
+
+	t.Run("lists the rule suites of an organization", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri: "/orgs/example/rulesets/rule-suites?per_page=100&rule_suite_result=bypass&time_period=week",
+				ResponseHeaders: map[string]string{
+					"Link": `</orgs/example/rulesets/rule-suites?page=2&per_page=100&rule_suite_result=bypass&time_period=week>; rel="next"`,
+				},
+				ResponseBody: `[` + ruleSuite + `]`,
+				StatusCode:   http.StatusOK,
+			},
+			{
+				ExpectedUri:  "/orgs/example/rulesets/rule-suites?page=2&per_page=100&rule_suite_result=bypass&time_period=week",
+				ResponseBody: `[{"id": 22, "result": "bypass"}]`,
+				StatusCode:   http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, dataSourceGithubRulesetRuleSuites().Schema, map[string]interface{}{
+			"time_period":       "week",
+			"rule_suite_result": "bypass",
+		})
+
+		err := dataSourceGithubRulesetRuleSuitesRead(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if count := d.Get("rule_suites.#").(int); count != 2 {
+			t.Fatalf("Expected 2 rule suites, got %d", count)
+		}
+		if name := d.Get("rule_suites.0.repository_name").(string); name != "hello-world" {
+			t.Errorf("Expected repository hello-world, got %q", name)
+		}
+		if result := d.Get("rule_suites.0.evaluation_result").(string); result != "fail" {
+			t.Errorf("Expected evaluation result fail, got %q", result)
+		}
+		if pushedAt := d.Get("rule_suites.0.pushed_at").(string); pushedAt != "2024-05-01 12:00:00 +0000 UTC" {
+			t.Errorf("Unexpected pushed_at %q", pushedAt)
+		}
+		if id := d.Get("rule_suites.1.id").(int); id != 22 {
+			t.Errorf("Expected the second rule suite to have ID 22, got %d", id)
+		}
+	})
+
+	t.Run("lists the rule suites of a repository", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  "/repos/example/hello-world/rulesets/rule-suites?actor_name=octocat&per_page=100&ref=refs%2Fheads%2Fmain&rule_suite_result=all&time_period=day",
+				ResponseBody: `[` + ruleSuite + `]`,
+				StatusCode:   http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client}
+
+		d := schema.TestResourceDataRaw(t, dataSourceGithubRulesetRuleSuites().Schema, map[string]interface{}{
+			"repository": "hello-world",
+			"ref":        "refs/heads/main",
+			"actor_name": "octocat",
+		})
+
+		err := dataSourceGithubRulesetRuleSuitesRead(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if count := d.Get("rule_suites.#").(int); count != 1 {
+			t.Fatalf("Expected 1 rule suite, got %d", count)
+		}
+		if d.Id() != "example:hello-world" {
+			t.Errorf("Unexpected ID %q", d.Id())
+		}
+	})
+}
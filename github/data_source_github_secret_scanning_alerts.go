@@ -0,0 +1,161 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGithubSecretScanningAlerts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubSecretScanningAlertsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the repository to list the alerts of. If not set, the alerts of the organization are listed.",
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "open",
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"open", "resolved"}, false), "state"),
+				Description:      "The state of the alerts to list. Can be one of 'open' or 'resolved'.",
+			},
+			"secret_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A comma-separated list of secret types to filter the alerts by.",
+			},
+			"resolution": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A comma-separated list of resolutions to filter the alerts by, e.g. 'false_positive,wont_fix'.",
+			},
+			"alerts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"repository": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_type_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resolution": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"push_protection_bypassed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubSecretScanningAlertsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	ctx := context.Background()
+
+	if repoName == "" {
+		if err := checkOrganization(meta); err != nil {
+			return err
+		}
+	}
+
+	options := &github.SecretScanningAlertListOptions{
+		State:             d.Get("state").(string),
+		SecretType:        d.Get("secret_type").(string),
+		Resolution:        d.Get("resolution").(string),
+		ListCursorOptions: github.ListCursorOptions{PerPage: maxPerPage},
+	}
+
+	alerts := make([]interface{}, 0)
+	for {
+		var page []*github.SecretScanningAlert
+		var resp *github.Response
+		var err error
+		if repoName != "" {
+			page, resp, err = client.SecretScanning.ListAlertsForRepo(ctx, owner, repoName, options)
+		} else {
+			page, resp, err = client.SecretScanning.ListAlertsForOrg(ctx, owner, options)
+		}
+		if err != nil {
+			return err
+		}
+
+		// The secrets themselves are left out, so they don't end up in state.
+		for _, alert := range page {
+			repository := alert.GetRepository().GetFullName()
+			if repository == "" {
+				repository = owner + "/" + repoName
+			}
+			createdAt := ""
+			if alert.CreatedAt != nil {
+				createdAt = alert.CreatedAt.String()
+			}
+
+			alerts = append(alerts, map[string]interface{}{
+				"number":                   alert.GetNumber(),
+				"repository":               repository,
+				"state":                    alert.GetState(),
+				"secret_type":              alert.GetSecretType(),
+				"secret_type_display_name": alert.GetSecretTypeDisplayName(),
+				"resolution":               alert.GetResolution(),
+				"push_protection_bypassed": alert.GetPushProtectionBypassed(),
+				"html_url":                 alert.GetHTMLURL(),
+				"created_at":               createdAt,
+			})
+		}
+
+		// Depending on the endpoint and GitHub version, the alerts are
+		// paginated by cursor or by page number.
+		if resp.After != "" {
+			options.After = resp.After
+		} else if resp.NextPage != 0 {
+			options.ListOptions.Page = resp.NextPage
+		} else {
+			break
+		}
+	}
+
+	if repoName != "" {
+		d.SetId(buildTwoPartID(owner, repoName))
+	} else {
+		d.SetId(owner)
+	}
+
+	return d.Set("alerts", alerts)
+}
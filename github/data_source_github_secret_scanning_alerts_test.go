@@ -0,0 +1,57 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceGithubSecretScanningAlertsRead(t *testing.T) {
+	t.Run("lists the alerts of an organization across pages", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:     "/orgs/example/secret-scanning/alerts?per_page=100&state=open",
+				ExpectedMethod:  "GET",
+				ResponseHeaders: map[string]string{"Link": `<https://api.github.com/orgs/example/secret-scanning/alerts?after=abc>; rel="next"`},
+				ResponseBody:    `[{"number": 1, "state": "open", "secret_type": "github_personal_access_token", "secret": "ghp_hidden", "repository": {"full_name": "example/one"}}]`,
+				StatusCode:      http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/secret-scanning/alerts?after=abc&per_page=100&state=open",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"number": 7, "state": "open", "secret_type": "aws_access_key_id", "push_protection_bypassed": true, "repository": {"full_name": "example/two"}}]`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, dataSourceGithubSecretScanningAlerts().Schema, map[string]interface{}{})
+
+		err := dataSourceGithubSecretScanningAlertsRead(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if count := d.Get("alerts.#").(int); count != 2 {
+			t.Fatalf("Expected 2 alerts, got %d", count)
+		}
+		if v := d.Get("alerts.0.repository").(string); v != "example/one" {
+			t.Errorf("Unexpected repository %q", v)
+		}
+		if v := d.Get("alerts.1.push_protection_bypassed").(bool); !v {
+			t.Error("Expected the push protection to be bypassed")
+		}
+		if d.Id() != "example" {
+			t.Errorf("Unexpected ID %q", d.Id())
+		}
+	})
+}
@@ -0,0 +1,127 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func dataSourceGithubUserByEmail() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubUserByEmailRead,
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The email address to look up the user of.",
+			},
+			"login": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The login of the user.",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Where the user was found: 'saml_identity' for the SAML identity of an organization member, or 'search' for a public email address.",
+			},
+		},
+	}
+}
+
+func dataSourceGithubUserByEmailRead(d *schema.ResourceData, meta interface{}) error {
+	email := d.Get("email").(string)
+	ctx := context.Background()
+
+	source := "saml_identity"
+	login, err := lookupLoginBySAMLIdentity(ctx, meta, email)
+	if err != nil {
+		return err
+	}
+
+	if login == "" {
+		source = "search"
+		login, err = lookupLoginBySearch(ctx, meta, email)
+		if err != nil {
+			return err
+		}
+	}
+
+	if login == "" {
+		return fmt.Errorf("no GitHub user found with email %s", email)
+	}
+
+	d.SetId(login)
+	if err = d.Set("login", login); err != nil {
+		return err
+	}
+	return d.Set("source", source)
+}
+
+// lookupLoginBySAMLIdentity returns the login of the organization member
+// whose SAML NameID is the email address. It returns an empty login when the
+// owner is not an organization, or the organization has no SAML identity
+// provider or no matching member.
+func lookupLoginBySAMLIdentity(ctx context.Context, meta interface{}, email string) (string, error) {
+	if !meta.(*Owner).IsOrganization {
+		return "", nil
+	}
+
+	var query struct {
+		Organization struct {
+			SamlIdentityProvider *struct {
+				ExternalIdentities `graphql:"externalIdentities(first: 1, userName: $userName)"`
+			}
+		} `graphql:"organization(login: $login)"`
+	}
+	variables := map[string]interface{}{
+		"login":    githubv4.String(meta.(*Owner).name),
+		"userName": githubv4.String(email),
+	}
+
+	err := meta.(*Owner).v4client.Query(ctx, &query, variables)
+	if err != nil {
+		return "", err
+	}
+
+	if query.Organization.SamlIdentityProvider == nil {
+		log.Printf("[DEBUG] Organization %s has no SAML identity provider, searching for %s instead", meta.(*Owner).name, email)
+		return "", nil
+	}
+	for _, edge := range query.Organization.SamlIdentityProvider.ExternalIdentities.Edges {
+		if edge.Node.User.Login != "" {
+			return string(edge.Node.User.Login), nil
+		}
+	}
+	return "", nil
+}
+
+// lookupLoginBySearch returns the login of the only user with the email
+// address as their public email. Private email addresses can't be searched.
+func lookupLoginBySearch(ctx context.Context, meta interface{}, email string) (string, error) {
+	client := meta.(*Owner).v3client
+
+	result, _, err := client.Search.Users(ctx, fmt.Sprintf("%s in:email type:user", email), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var logins []string
+	for _, user := range result.Users {
+		logins = append(logins, user.GetLogin())
+	}
+
+	switch len(logins) {
+	case 0:
+		return "", nil
+	case 1:
+		return logins[0], nil
+	default:
+		return "", fmt.Errorf("found more than one GitHub user with email %s: %s", email, strings.Join(logins, ", "))
+	}
+}
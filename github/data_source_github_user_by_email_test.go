@@ -0,0 +1,98 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestDataSourceGithubUserByEmailRead(t *testing.T) {
+	newMeta := func(ts string, isOrganization bool) *Owner {
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts + "/")
+		client.BaseURL = u
+		return &Owner{
+			name:           "example",
+			v3client:       client,
+			v4client:       githubv4.NewEnterpriseClient(ts+"/graphql", nil),
+			IsOrganization: isOrganization,
+		}
+	}
+
+	newData := func(t *testing.T) *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, dataSourceGithubUserByEmail().Schema, map[string]interface{}{
+			"email": "octocat@example.com",
+		})
+	}
+
+	t.Run("finds the user by their SAML identity", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  "/graphql",
+				ResponseBody: `{"data": {"organization": {"samlIdentityProvider": {"externalIdentities": {"edges": [{"node": {"user": {"login": "octocat"}, "samlIdentity": {"nameId": "octocat@example.com"}}}]}}}}}`,
+				StatusCode:   http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := newData(t)
+		err := dataSourceGithubUserByEmailRead(d, newMeta(ts.URL, true))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if login := d.Get("login").(string); login != "octocat" {
+			t.Errorf("Expected login octocat, got %q", login)
+		}
+		if source := d.Get("source").(string); source != "saml_identity" {
+			t.Errorf("Expected source saml_identity, got %q", source)
+		}
+	})
+
+	t.Run("falls back to searching public emails without SAML", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  "/graphql",
+				ResponseBody: `{"data": {"organization": {"samlIdentityProvider": null}}}`,
+				StatusCode:   http.StatusOK,
+			},
+			{
+				ExpectedUri:  "/search/users?q=octocat%40example.com+in%3Aemail+type%3Auser",
+				ResponseBody: `{"total_count": 1, "items": [{"login": "octocat"}]}`,
+				StatusCode:   http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := newData(t)
+		err := dataSourceGithubUserByEmailRead(d, newMeta(ts.URL, true))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if login := d.Get("login").(string); login != "octocat" {
+			t.Errorf("Expected login octocat, got %q", login)
+		}
+		if source := d.Get("source").(string); source != "search" {
+			t.Errorf("Expected source search, got %q", source)
+		}
+	})
+
+	t.Run("fails when no user has the email", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  "/search/users?q=octocat%40example.com+in%3Aemail+type%3Auser",
+				ResponseBody: `{"total_count": 0, "items": []}`,
+				StatusCode:   http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		err := dataSourceGithubUserByEmailRead(newData(t), newMeta(ts.URL, false))
+		if err == nil {
+			t.Fatal("Expected an error, got none")
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubBranchProtectionV3V0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"required_status_checks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"include_admins": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"strict": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"contexts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"checks": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGithubBranchProtectionV3UpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	rscList, ok := rawState["required_status_checks"].([]interface{})
+	if !ok {
+		return rawState, nil
+	}
+
+	for _, v := range rscList {
+		rsc, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		checks, _ := rsc["checks"].([]interface{})
+		requiredChecks := make([]interface{}, 0, len(checks))
+		for _, c := range checks {
+			// Contexts containing a ":" without an app ID, e.g. "ci:lint", are
+			// kept whole rather than failing the upgrade.
+			check, err := parseRequiredStatusCheck(c.(string))
+			if err != nil {
+				check = &github.RequiredStatusCheck{Context: c.(string)}
+			}
+			requiredChecks = append(requiredChecks, map[string]interface{}{
+				"context":        check.Context,
+				"integration_id": check.GetAppID(),
+			})
+		}
+
+		rsc["required_check"] = requiredChecks
+		delete(rsc, "checks")
+	}
+
+	return rawState, nil
+}
@@ -0,0 +1,65 @@
+package github
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestMigrateGithubBranchProtectionV3StateV0toV1(t *testing.T) {
+	rawState := map[string]interface{}{
+		"repository": "example",
+		"branch":     "main",
+		"required_status_checks": []interface{}{
+			map[string]interface{}{
+				"strict": true,
+				"checks": []interface{}{
+					"github/foo",
+					"github/bar:-1",
+					"github:foo:baz:1",
+					"ci:lint",
+				},
+			},
+		},
+	}
+
+	newState, err := resourceGithubBranchProtectionV3UpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedState := map[string]interface{}{
+		"repository": "example",
+		"branch":     "main",
+		"required_status_checks": []interface{}{
+			map[string]interface{}{
+				"strict": true,
+				"required_check": []interface{}{
+					map[string]interface{}{"context": "github/foo", "integration_id": int64(0)},
+					map[string]interface{}{"context": "github/bar", "integration_id": int64(-1)},
+					map[string]interface{}{"context": "github:foo:baz", "integration_id": int64(1)},
+					map[string]interface{}{"context": "ci:lint", "integration_id": int64(0)},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(newState, expectedState) {
+		t.Fatalf("Expected state:\n%#v\n\nGiven:\n%#v\n", expectedState, newState)
+	}
+}
+
+func TestMigrateGithubBranchProtectionV3StateV0toV1WithoutChecks(t *testing.T) {
+	rawState := map[string]interface{}{
+		"repository": "example",
+		"branch":     "main",
+	}
+
+	newState, err := resourceGithubBranchProtectionV3UpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := newState["required_status_checks"]; ok {
+		t.Fatalf("Expected no required_status_checks, got %#v", newState["required_status_checks"])
+	}
+}
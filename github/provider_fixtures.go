@@ -0,0 +1,287 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+)
+
+// Acceptance tests can be recorded against a live organization and replayed
+// later without one, by setting GITHUB_TEST_FIXTURES to "record" or "replay".
+// Each test that passes skipUnlessMode gets its own fixture file in
+// testdata/fixtures, containing the requests made by the provider and the
+// responses GitHub returned. Request headers are never recorded, so tokens
+// don't end up in the fixtures. Tests in anonymous mode aren't recorded.
+const (
+	testFixturesRecord = "record"
+	testFixturesReplay = "replay"
+
+	testFixturesDir = "testdata/fixtures"
+
+	// testFixturesSeed seeds the random names generated by the tests, so the
+	// names of a replayed test match the recorded ones.
+	testFixturesSeed = 1
+)
+
+var testFixturesMode = os.Getenv("GITHUB_TEST_FIXTURES")
+
+// testFixturesEnv holds the names of the environment variables recorded in
+// testdata/fixtures/env.json, so replaying needs no configuration.
+var testFixturesEnv = []string{
+	"GITHUB_OWNER",
+	"GITHUB_ORGANIZATION",
+	"GITHUB_TEST_USER",
+	"GITHUB_TEST_COLLABORATOR",
+	"GITHUB_TEST_ORGANIZATION",
+	"GITHUB_TEMPLATE_REPOSITORY",
+	"GITHUB_TEMPLATE_REPOSITORY_RELEASE_ID",
+	"GITHUB_BASE_URL",
+	"ENTERPRISE_ACCOUNT",
+	"ENTERPRISE_SLUG",
+}
+
+var testFixtures = &fixtureTransport{}
+
+// startTestFixture starts recording or replaying the fixture of the test,
+// skipping the test if there is nothing to replay.
+func startTestFixture(t *testing.T) {
+	if testFixturesMode == "" {
+		return
+	}
+	if !testFixtures.start(t) {
+		t.Skipf("Skipping %s which has no recorded fixture", t.Name())
+	}
+}
+
+type fixtureInteraction struct {
+	Method          string              `json:"method"`
+	URL             string              `json:"url"`
+	RequestBody     string              `json:"request_body,omitempty"`
+	StatusCode      int                 `json:"status_code"`
+	ResponseHeaders map[string][]string `json:"response_headers,omitempty"`
+	ResponseBody    string              `json:"response_body,omitempty"`
+
+	replayed bool
+}
+
+// fixtureTransport records the interactions of the running test to its
+// fixture, or replays them from it.
+type fixtureTransport struct {
+	transport    http.RoundTripper
+	mode         string
+	dir          string
+	path         string
+	interactions []*fixtureInteraction
+
+	m sync.Mutex
+}
+
+func (ft *fixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ft.m.Lock()
+	defer ft.m.Unlock()
+
+	if ft.path == "" {
+		if ft.mode == testFixturesReplay {
+			return nil, fmt.Errorf("no fixture to replay %s %s from", req.Method, req.URL)
+		}
+		return ft.transport.RoundTrip(req)
+	}
+
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		requestBody, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	if ft.mode == testFixturesReplay {
+		return ft.replay(req, string(requestBody))
+	}
+
+	resp, err := ft.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	ft.interactions = append(ft.interactions, &fixtureInteraction{
+		Method:          req.Method,
+		URL:             req.URL.RequestURI(),
+		RequestBody:     string(requestBody),
+		StatusCode:      resp.StatusCode,
+		ResponseHeaders: resp.Header,
+		ResponseBody:    string(responseBody),
+	})
+
+	return resp, nil
+}
+
+// replay returns the response to the first interaction matching the request
+// that wasn't replayed yet. Terraform may make independent requests in any
+// order, so the interactions aren't required to be replayed in order.
+func (ft *fixtureTransport) replay(req *http.Request, requestBody string) (*http.Response, error) {
+	for _, interaction := range ft.interactions {
+		if interaction.replayed || interaction.Method != req.Method ||
+			interaction.URL != req.URL.RequestURI() || interaction.RequestBody != requestBody {
+			continue
+		}
+		interaction.replayed = true
+
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+			StatusCode: interaction.StatusCode,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header(interaction.ResponseHeaders).Clone(),
+			Body:       io.NopCloser(bytes.NewBufferString(interaction.ResponseBody)),
+			Request:    req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no interaction recorded in %s matches %s %s", ft.path, req.Method, req.URL.RequestURI())
+}
+
+// start loads the fixture of the test, or clears the interactions when
+// recording. It reports whether there is a fixture to replay.
+func (ft *fixtureTransport) start(t *testing.T) bool {
+	ft.m.Lock()
+	defer ft.m.Unlock()
+
+	ft.path = filepath.Join(ft.dir, testFixtureName(t.Name())+".json")
+	ft.interactions = nil
+
+	t.Cleanup(ft.stop(t))
+
+	if ft.mode == testFixturesRecord {
+		return true
+	}
+
+	content, err := os.ReadFile(ft.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = json.Unmarshal(content, &ft.interactions); err != nil {
+		t.Fatalf("invalid fixture %s: %s", ft.path, err)
+	}
+	return true
+}
+
+func (ft *fixtureTransport) stop(t *testing.T) func() {
+	return func() {
+		ft.m.Lock()
+		defer ft.m.Unlock()
+
+		path := ft.path
+		ft.path = ""
+
+		// Failed recordings are incomplete, so the previous fixture is kept.
+		if ft.mode != testFixturesRecord || t.Failed() {
+			return
+		}
+
+		if err := writeTestFixture(path, ft.interactions); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+var testFixtureNameRegexp = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+func testFixtureName(testName string) string {
+	return testFixtureNameRegexp.ReplaceAllString(testName, "_")
+}
+
+func writeTestFixture(path string, v interface{}) error {
+	content, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(content, '\n'), 0o644)
+}
+
+// setupTestFixtures routes the requests of the provider through the fixture
+// transport when GITHUB_TEST_FIXTURES is set. It must run before the tests.
+func setupTestFixtures() error {
+	switch testFixturesMode {
+	case "":
+		return nil
+	case testFixturesRecord, testFixturesReplay:
+	default:
+		return fmt.Errorf("GITHUB_TEST_FIXTURES must be %q or %q, got %q", testFixturesRecord, testFixturesReplay, testFixturesMode)
+	}
+
+	envPath := filepath.Join(testFixturesDir, "env.json")
+	if testFixturesMode == testFixturesRecord {
+		env := make(map[string]string)
+		for _, name := range testFixturesEnv {
+			if v := os.Getenv(name); v != "" {
+				env[name] = v
+			}
+		}
+		if err := writeTestFixture(envPath, env); err != nil {
+			return err
+		}
+	} else {
+		content, err := os.ReadFile(envPath)
+		if err != nil {
+			return fmt.Errorf("no fixtures recorded: %w", err)
+		}
+		var env map[string]string
+		if err = json.Unmarshal(content, &env); err != nil {
+			return err
+		}
+		for name, v := range env {
+			if err = os.Setenv(name, v); err != nil {
+				return err
+			}
+		}
+		// Requests are never sent, but the provider requires a token.
+		if err = os.Setenv("GITHUB_TOKEN", "replayed"); err != nil {
+			return err
+		}
+
+		testCollaborator = os.Getenv("GITHUB_TEST_COLLABORATOR")
+		isEnterprise = os.Getenv("ENTERPRISE_ACCOUNT")
+		testEnterprise = os.Getenv("ENTERPRISE_SLUG")
+		testOrganization = testOrganizationFunc()
+		testOwner = os.Getenv("GITHUB_OWNER")
+		testToken = os.Getenv("GITHUB_TOKEN")
+	}
+
+	log.Printf("[INFO] Using %s mode for the test fixtures in %s", testFixturesMode, testFixturesDir)
+	rand.Seed(testFixturesSeed) //nolint:staticcheck // The global source is the one used by acctest.
+
+	// The authenticated HTTP client uses the default transport.
+	testFixtures.transport = http.DefaultTransport
+	testFixtures.mode = testFixturesMode
+	testFixtures.dir = testFixturesDir
+	http.DefaultTransport = testFixtures
+
+	return nil
+}
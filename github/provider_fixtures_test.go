@@ -0,0 +1,102 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFixtureTransport(t *testing.T) {
+	dir := t.TempDir()
+
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Link", `<https://api.github.com/user/repos?page=2>; rel="next"`)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"name": "` + string(body) + `"}`))
+	}))
+	defer ts.Close()
+
+	send := func(t *testing.T, ft *fixtureTransport, body string) (*http.Response, string) {
+		req, _ := http.NewRequest("POST", ts.URL+"/user/repos?per_page=100", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer secret")
+		resp, err := ft.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp, string(respBody)
+	}
+
+	t.Run("records", func(t *testing.T) {
+		ft := &fixtureTransport{transport: http.DefaultTransport, mode: testFixturesRecord, dir: dir}
+		t.Run("the test", func(t *testing.T) {
+			ft.start(t)
+			send(t, ft, "one")
+			send(t, ft, "two")
+		})
+	})
+
+	if requests != 2 {
+		t.Fatalf("Expected 2 requests to be recorded, got %d", requests)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "TestFixtureTransport_records_the_test.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(content), "secret") {
+		t.Error("Expected the request headers not to be recorded")
+	}
+	// Fixtures are named after their test, so the recording is replayed by
+	// a test of another name here.
+	err = os.WriteFile(filepath.Join(dir, "TestFixtureTransport_replays_the_test.json"), content, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("replays", func(t *testing.T) {
+		ft := &fixtureTransport{transport: http.DefaultTransport, mode: testFixturesReplay, dir: dir}
+		t.Run("the test", func(t *testing.T) {
+			if !ft.start(t) {
+				t.Fatal("Expected a fixture to replay")
+			}
+
+			// Requests may be replayed out of order.
+			resp, body := send(t, ft, "two")
+			if resp.StatusCode != http.StatusCreated || body != `{"name": "two"}` {
+				t.Errorf("Unexpected response %d %q", resp.StatusCode, body)
+			}
+			if !strings.Contains(resp.Header.Get("Link"), `rel="next"`) {
+				t.Errorf("Unexpected headers %v", resp.Header)
+			}
+			_, body = send(t, ft, "one")
+			if body != `{"name": "one"}` {
+				t.Errorf("Unexpected response %q", body)
+			}
+
+			req, _ := http.NewRequest("POST", ts.URL+"/user/repos?per_page=100", strings.NewReader("one"))
+			if _, err := ft.RoundTrip(req); err == nil {
+				t.Error("Expected an error replaying an interaction twice")
+			}
+		})
+	})
+
+	if requests != 2 {
+		t.Errorf("Expected no requests while replaying, got %d", requests-2)
+	}
+
+	t.Run("skips tests without a fixture", func(t *testing.T) {
+		ft := &fixtureTransport{transport: http.DefaultTransport, mode: testFixturesReplay, dir: dir}
+		if ft.start(t) {
+			t.Error("Expected no fixture to replay")
+		}
+	})
+}
@@ -54,17 +54,20 @@ func skipUnlessMode(t *testing.T, providerMode string) {
 		if os.Getenv("GITHUB_TOKEN") == "" {
 			t.Log("GITHUB_TOKEN environment variable should be set")
 		} else {
+			startTestFixture(t)
 			return
 		}
 
 	case individual:
 		if os.Getenv("GITHUB_TOKEN") != "" && os.Getenv("GITHUB_OWNER") != "" {
+			startTestFixture(t)
 			return
 		} else {
 			t.Log("GITHUB_TOKEN and GITHUB_OWNER environment variables should be set")
 		}
 	case organization:
 		if os.Getenv("GITHUB_TOKEN") != "" && os.Getenv("GITHUB_ORGANIZATION") != "" {
+			startTestFixture(t)
 			return
 		} else {
 			t.Log("GITHUB_TOKEN and GITHUB_ORGANIZATION environment variables should be set")
@@ -0,0 +1,73 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubActionsOrganizationForkPullRequestWorkflows() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubActionsOrganizationForkPullRequestWorkflowsCreateOrUpdate,
+		Read:   resourceGithubActionsOrganizationForkPullRequestWorkflowsRead,
+		Update: resourceGithubActionsOrganizationForkPullRequestWorkflowsCreateOrUpdate,
+		Delete: resourceGithubActionsOrganizationForkPullRequestWorkflowsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: forkPullRequestWorkflowsSchema(),
+	}
+}
+
+func organizationActionsPermissionsPath(orgName string) string {
+	return fmt.Sprintf("orgs/%s/actions/permissions", orgName)
+}
+
+func resourceGithubActionsOrganizationForkPullRequestWorkflowsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	err = applyForkPullRequestWorkflows(ctx, client, organizationActionsPermissionsPath(orgName), d)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(orgName)
+	return resourceGithubActionsOrganizationForkPullRequestWorkflowsRead(d, meta)
+}
+
+func resourceGithubActionsOrganizationForkPullRequestWorkflowsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	return readForkPullRequestWorkflows(ctx, client, organizationActionsPermissionsPath(d.Id()), d)
+}
+
+func resourceGithubActionsOrganizationForkPullRequestWorkflowsDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	return resetForkPullRequestWorkflows(ctx, client, organizationActionsPermissionsPath(d.Id()), d)
+}
@@ -0,0 +1,87 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubActionsRepositoryForkPullRequestWorkflows() *schema.Resource {
+	s := forkPullRequestWorkflowsSchema()
+	s["repository"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The GitHub repository.",
+	}
+
+	return &schema.Resource{
+		Create: resourceGithubActionsRepositoryForkPullRequestWorkflowsCreateOrUpdate,
+		Read:   resourceGithubActionsRepositoryForkPullRequestWorkflowsRead,
+		Update: resourceGithubActionsRepositoryForkPullRequestWorkflowsCreateOrUpdate,
+		Delete: resourceGithubActionsRepositoryForkPullRequestWorkflowsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: s,
+	}
+}
+
+func repositoryActionsPermissionsPath(owner, repoName string) string {
+	return fmt.Sprintf("repos/%s/%s/actions/permissions", owner, repoName)
+}
+
+func resourceGithubActionsRepositoryForkPullRequestWorkflowsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	err := applyForkPullRequestWorkflows(ctx, client, repositoryActionsPermissionsPath(owner, repoName), d)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+	return resourceGithubActionsRepositoryForkPullRequestWorkflowsRead(d, meta)
+}
+
+func resourceGithubActionsRepositoryForkPullRequestWorkflowsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	err := readForkPullRequestWorkflows(ctx, client, repositoryActionsPermissionsPath(owner, repoName), d)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing fork pull request workflow settings for %s/%s from state because the repository no longer exists in GitHub",
+				owner, repoName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return d.Set("repository", repoName)
+}
+
+func resourceGithubActionsRepositoryForkPullRequestWorkflowsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	return resetForkPullRequestWorkflows(ctx, client, repositoryActionsPermissionsPath(owner, repoName), d)
+}
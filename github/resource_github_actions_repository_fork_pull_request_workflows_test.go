@@ -0,0 +1,79 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubActionsRepositoryForkPullRequestWorkflows(t *testing.T) {
+
+	t.Run("manages fork pull request workflow settings of a private repository", func(t *testing.T) {
+
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name       = "tf-acc-test-fork-pr-%s"
+				visibility = "private"
+			}
+
+			resource "github_actions_repository_fork_pull_request_workflows" "test" {
+				repository      = github_repository.test.name
+				approval_policy = "all_external_contributors"
+
+				private_repositories {
+					run_workflows_from_fork_pull_requests  = true
+					require_approval_for_fork_pr_workflows = true
+				}
+			}
+		`, randomID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(
+				"github_actions_repository_fork_pull_request_workflows.test", "approval_policy", "all_external_contributors",
+			),
+			resource.TestCheckResourceAttr(
+				"github_actions_repository_fork_pull_request_workflows.test", "private_repositories.0.run_workflows_from_fork_pull_requests", "true",
+			),
+			resource.TestCheckResourceAttr(
+				"github_actions_repository_fork_pull_request_workflows.test", "private_repositories.0.send_secrets_and_variables", "false",
+			),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+					{
+						ResourceName:      "github_actions_repository_fork_pull_request_workflows.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+						ImportStateVerifyIgnore: []string{
+							"private_repositories",
+						},
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
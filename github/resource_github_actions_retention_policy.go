@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubActionsRetentionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubActionsRetentionPolicyCreateOrUpdate,
+		Read:   resourceGithubActionsRetentionPolicyRead,
+		Update: resourceGithubActionsRetentionPolicyCreateOrUpdate,
+		Delete: resourceGithubActionsRetentionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The GitHub repository. When not set, the retention policy of the organization is managed.",
+			},
+			"days": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: toDiagFunc(validation.IntBetween(1, 400), "days"),
+				Description:      "The number of days artifacts and logs are retained for.",
+			},
+		},
+	}
+}
+
+// The default retention period of artifacts and logs, restored on delete.
+const defaultActionsRetentionDays = 90
+
+type actionsRetentionPolicy struct {
+	Days int `json:"days"`
+}
+
+// actionsRetentionPolicyPath returns the API path of the retention policy
+// for a resource ID of either "<org>" or "<org>:<repository>".
+func actionsRetentionPolicyPath(id string) string {
+	if strings.Contains(id, ":") {
+		owner, repoName, _ := parseTwoPartID(id, "owner", "repository")
+		return repositoryActionsPermissionsPath(owner, repoName) + "/artifact-and-log-retention"
+	}
+	return organizationActionsPermissionsPath(id) + "/artifact-and-log-retention"
+}
+
+func resourceGithubActionsRetentionPolicyCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	id := owner
+	if repoName, ok := d.GetOk("repository"); ok {
+		id = buildTwoPartID(owner, repoName.(string))
+	} else if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	req, err := client.NewRequest("PUT", actionsRetentionPolicyPath(id), &actionsRetentionPolicy{
+		Days: d.Get("days").(int),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("error setting actions retention policy of %s: %s", id, err)
+	}
+
+	d.SetId(id)
+	return resourceGithubActionsRetentionPolicyRead(d, meta)
+}
+
+func resourceGithubActionsRetentionPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	req, err := client.NewRequest("GET", actionsRetentionPolicyPath(d.Id()), nil)
+	if err != nil {
+		return err
+	}
+
+	policy := new(actionsRetentionPolicy)
+	_, err = client.Do(ctx, req, policy)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing actions retention policy %s from state because it no longer exists in GitHub", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if _, repoName, err := parseTwoPartID(d.Id(), "owner", "repository"); err == nil {
+		if err = d.Set("repository", repoName); err != nil {
+			return err
+		}
+	}
+
+	return d.Set("days", policy.Days)
+}
+
+func resourceGithubActionsRetentionPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	req, err := client.NewRequest("PUT", actionsRetentionPolicyPath(d.Id()), &actionsRetentionPolicy{
+		Days: defaultActionsRetentionDays,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
@@ -0,0 +1,135 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubActionsRunnerGroupRepositoryAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubActionsRunnerGroupRepositoryAccessCreate,
+		Read:   resourceGithubActionsRunnerGroupRepositoryAccessRead,
+		Delete: resourceGithubActionsRunnerGroupRepositoryAccessDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"runner_group_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the existing runner group.",
+			},
+			"repository_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the repository that can access the runner group.",
+			},
+		},
+	}
+}
+
+func resourceGithubActionsRunnerGroupRepositoryAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	runnerGroupID := int64(d.Get("runner_group_id").(int))
+	repositoryID := int64(d.Get("repository_id").(int))
+
+	_, err = client.Actions.AddRepositoryAccessRunnerGroup(ctx, owner, runnerGroupID, repositoryID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(strconv.FormatInt(runnerGroupID, 10), strconv.FormatInt(repositoryID, 10)))
+	return resourceGithubActionsRunnerGroupRepositoryAccessRead(d, meta)
+}
+
+func resourceGithubActionsRunnerGroupRepositoryAccessRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	runnerGroupIDString, repositoryIDString, err := parseTwoPartID(d.Id(), "runner_group_id", "repository_id")
+	if err != nil {
+		return err
+	}
+	runnerGroupID, err := strconv.ParseInt(runnerGroupIDString, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(runnerGroupIDString, err)
+	}
+	repositoryID, err := strconv.ParseInt(repositoryIDString, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(repositoryIDString, err)
+	}
+
+	opt := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+	for {
+		results, resp, err := client.Actions.ListRepositoryAccessRunnerGroup(ctx, owner, runnerGroupID, opt)
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok {
+				if ghErr.Response.StatusCode == http.StatusNotFound {
+					log.Printf("[INFO] Removing repository %d from state because runner group %d no longer exists in GitHub", repositoryID, runnerGroupID)
+					d.SetId("")
+					return nil
+				}
+			}
+			return err
+		}
+
+		for _, repo := range results.Repositories {
+			if repo.GetID() == repositoryID {
+				if err = d.Set("runner_group_id", runnerGroupID); err != nil {
+					return err
+				}
+				return d.Set("repository_id", repositoryID)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	log.Printf("[INFO] Removing repository %d from state because it no longer has access to runner group %d", repositoryID, runnerGroupID)
+	d.SetId("")
+	return nil
+}
+
+func resourceGithubActionsRunnerGroupRepositoryAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	runnerGroupID := int64(d.Get("runner_group_id").(int))
+	repositoryID := int64(d.Get("repository_id").(int))
+
+	_, err = client.Actions.RemoveRepositoryAccessRunnerGroup(ctx, owner, runnerGroupID, repositoryID)
+	return err
+}
@@ -0,0 +1,76 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubActionsRunnerGroupRepositoryAccess(t *testing.T) {
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("adds a single repository to a runner group", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name = "tf-acc-test-%[1]s"
+			}
+
+			resource "github_actions_runner_group" "test" {
+				name       = "tf-acc-test-%[1]s"
+				visibility = "selected"
+
+				lifecycle {
+					ignore_changes = [selected_repository_ids]
+				}
+			}
+
+			resource "github_actions_runner_group_repository_access" "test" {
+				runner_group_id = github_actions_runner_group.test.id
+				repository_id   = github_repository.test.repo_id
+			}
+		`, randomID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrPair(
+				"github_actions_runner_group_repository_access.test", "runner_group_id",
+				"github_actions_runner_group.test", "id",
+			),
+			resource.TestCheckResourceAttrPair(
+				"github_actions_runner_group_repository_access.test", "repository_id",
+				"github_repository.test", "repo_id",
+			),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+					{
+						ResourceName:      "github_actions_runner_group_repository_access.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
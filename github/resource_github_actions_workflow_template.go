@@ -0,0 +1,429 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+)
+
+const workflowTemplatesDirectory = "workflow-templates"
+
+// workflowTemplateProperties is the metadata file GitHub reads next to each
+// workflow template, see
+// https://docs.github.com/en/actions/using-workflows/creating-starter-workflows-for-your-organization
+type workflowTemplateProperties struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description,omitempty"`
+	IconName     string   `json:"iconName,omitempty"`
+	Categories   []string `json:"categories,omitempty"`
+	FilePatterns []string `json:"filePatterns,omitempty"`
+}
+
+func resourceGithubActionsWorkflowTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubActionsWorkflowTemplateCreate,
+		Read:   resourceGithubActionsWorkflowTemplateRead,
+		Update: resourceGithubActionsWorkflowTemplateUpdate,
+		Delete: resourceGithubActionsWorkflowTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGithubActionsWorkflowTemplateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: toDiagFunc(validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9._-]+$`), "must only contain alphanumeric characters, '.', '_' or '-'"), "name"),
+				Description:      "The file name of the template, without extension.",
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     ".github",
+				Description: "The repository holding the organization's workflow templates.",
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The branch to commit the template to, defaults to the repository's default branch.",
+			},
+			"workflow": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateWorkflowTemplateWorkflow,
+				Description:      "The YAML content of the workflow template.",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the template shown to users.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the template shown to users.",
+			},
+			"icon_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of an SVG icon in the workflow templates directory, without extension, or an Octicon name prefixed with 'octicon '.",
+			},
+			"categories": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The categories the template is listed under.",
+			},
+			"file_patterns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "File patterns of the repository's root directory for which the template is suggested.",
+			},
+			"commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit message when creating, updating or deleting the template.",
+			},
+			"commit_author": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"commit_email"},
+				Description:  "The commit author name, defaults to the authenticated user's name.",
+			},
+			"commit_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"commit_author"},
+				Description:  "The commit author email address, defaults to the authenticated user's email address.",
+			},
+			"workflow_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The blob SHA of the workflow file.",
+			},
+			"properties_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The blob SHA of the properties file.",
+			},
+		},
+	}
+}
+
+func validateWorkflowTemplateWorkflow(v interface{}, path cty.Path) diag.Diagnostics {
+	var workflow map[string]interface{}
+	if err := yaml.Unmarshal([]byte(v.(string)), &workflow); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("workflow is not valid YAML: %s", err),
+			AttributePath: path,
+		}}
+	}
+	if _, ok := workflow["jobs"]; !ok {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "workflow must define jobs",
+			AttributePath: path,
+		}}
+	}
+	return nil
+}
+
+func resourceGithubActionsWorkflowTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	repo := d.Get("repository").(string)
+	name := d.Get("name").(string)
+
+	getOpts := &github.RepositoryContentGetOptions{}
+	if branch, ok := d.GetOk("branch"); ok {
+		if err = checkRepositoryBranchExists(client, owner, repo, branch.(string)); err != nil {
+			return err
+		}
+		getOpts.Ref = branch.(string)
+	}
+
+	for _, path := range []string{workflowTemplatePath(name), workflowTemplatePropertiesPath(name)} {
+		_, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, getOpts)
+		if err == nil {
+			return fmt.Errorf("%s already exists in %s/%s, import the workflow template instead", path, owner, repo)
+		}
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return err
+		}
+	}
+
+	properties, err := expandWorkflowTemplateProperties(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating workflow template: %s/%s/%s", owner, repo, name)
+	workflowSHA, err := putWorkflowTemplateFile(ctx, d, meta, workflowTemplatePath(name), []byte(d.Get("workflow").(string)), "", "Add")
+	if err != nil {
+		return err
+	}
+	if err = d.Set("workflow_sha", workflowSHA); err != nil {
+		return err
+	}
+
+	propertiesSHA, err := putWorkflowTemplateFile(ctx, d, meta, workflowTemplatePropertiesPath(name), properties, "", "Add")
+	if err != nil {
+		return err
+	}
+	if err = d.Set("properties_sha", propertiesSHA); err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(repo, name))
+
+	return resourceGithubActionsWorkflowTemplateRead(d, meta)
+}
+
+func resourceGithubActionsWorkflowTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repo, name, err := parseTwoPartID(d.Id(), "repository", "name")
+	if err != nil {
+		return err
+	}
+
+	opts := &github.RepositoryContentGetOptions{}
+	if branch, ok := d.GetOk("branch"); ok {
+		opts.Ref = branch.(string)
+	}
+
+	workflowFile, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, workflowTemplatePath(name), opts)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing workflow template %s/%s/%s from state because it no longer exists in GitHub",
+				owner, repo, name)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	workflow, err := workflowFile.GetContent()
+	if err != nil {
+		return err
+	}
+
+	propertiesFile, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, workflowTemplatePropertiesPath(name), opts)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing workflow template %s/%s/%s from state because its properties file no longer exists in GitHub",
+				owner, repo, name)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	content, err := propertiesFile.GetContent()
+	if err != nil {
+		return err
+	}
+
+	var properties workflowTemplateProperties
+	if err = json.Unmarshal([]byte(content), &properties); err != nil {
+		return fmt.Errorf("error parsing %s: %v", workflowTemplatePropertiesPath(name), err)
+	}
+
+	if err = d.Set("repository", repo); err != nil {
+		return err
+	}
+	if err = d.Set("name", name); err != nil {
+		return err
+	}
+	if err = d.Set("workflow", workflow); err != nil {
+		return err
+	}
+	if err = d.Set("workflow_sha", workflowFile.GetSHA()); err != nil {
+		return err
+	}
+	if err = d.Set("display_name", properties.Name); err != nil {
+		return err
+	}
+	if err = d.Set("description", properties.Description); err != nil {
+		return err
+	}
+	if err = d.Set("icon_name", properties.IconName); err != nil {
+		return err
+	}
+	if err = d.Set("categories", properties.Categories); err != nil {
+		return err
+	}
+	if err = d.Set("file_patterns", properties.FilePatterns); err != nil {
+		return err
+	}
+	return d.Set("properties_sha", propertiesFile.GetSHA())
+}
+
+func resourceGithubActionsWorkflowTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	name := d.Get("name").(string)
+
+	if d.HasChange("workflow") {
+		sha, err := putWorkflowTemplateFile(ctx, d, meta, workflowTemplatePath(name), []byte(d.Get("workflow").(string)), d.Get("workflow_sha").(string), "Update")
+		if err != nil {
+			return err
+		}
+		if err = d.Set("workflow_sha", sha); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChanges("display_name", "description", "icon_name", "categories", "file_patterns") {
+		properties, err := expandWorkflowTemplateProperties(d)
+		if err != nil {
+			return err
+		}
+		sha, err := putWorkflowTemplateFile(ctx, d, meta, workflowTemplatePropertiesPath(name), properties, d.Get("properties_sha").(string), "Update")
+		if err != nil {
+			return err
+		}
+		if err = d.Set("properties_sha", sha); err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubActionsWorkflowTemplateRead(d, meta)
+}
+
+func resourceGithubActionsWorkflowTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repo := d.Get("repository").(string)
+	name := d.Get("name").(string)
+
+	files := map[string]string{
+		workflowTemplatePath(name):           d.Get("workflow_sha").(string),
+		workflowTemplatePropertiesPath(name): d.Get("properties_sha").(string),
+	}
+	for path, sha := range files {
+		opts := workflowTemplateFileOptions(d, path, "Delete")
+		opts.SHA = github.String(sha)
+
+		log.Printf("[DEBUG] Deleting workflow template file: %s/%s/%s", owner, repo, path)
+		_, resp, err := client.Repositories.DeleteFile(ctx, owner, repo, path, opts)
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceGithubActionsWorkflowTemplateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	repo, name, err := parseTwoPartID(d.Id(), "repository", "name")
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set("repository", repo); err != nil {
+		return nil, err
+	}
+	if err = d.Set("name", name); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func workflowTemplatePath(name string) string {
+	return fmt.Sprintf("%s/%s.yml", workflowTemplatesDirectory, name)
+}
+
+func workflowTemplatePropertiesPath(name string) string {
+	return fmt.Sprintf("%s/%s.properties.json", workflowTemplatesDirectory, name)
+}
+
+func expandWorkflowTemplateProperties(d *schema.ResourceData) ([]byte, error) {
+	properties := workflowTemplateProperties{
+		Name:         d.Get("display_name").(string),
+		Description:  d.Get("description").(string),
+		IconName:     d.Get("icon_name").(string),
+		Categories:   expandStringList(d.Get("categories").([]interface{})),
+		FilePatterns: expandStringList(d.Get("file_patterns").([]interface{})),
+	}
+
+	content, err := json.MarshalIndent(properties, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
+
+// workflowTemplateFileOptions returns the commit options for one of the
+// template's files, using "<verb> <path>" as the default commit message.
+func workflowTemplateFileOptions(d *schema.ResourceData, path, verb string) *github.RepositoryContentFileOptions {
+	message := fmt.Sprintf("%s %s", verb, path)
+	if commitMessage, ok := d.GetOk("commit_message"); ok {
+		message = commitMessage.(string)
+	}
+
+	opts := &github.RepositoryContentFileOptions{
+		Message: github.String(message),
+	}
+
+	if branch, ok := d.GetOk("branch"); ok {
+		opts.Branch = github.String(branch.(string))
+	}
+
+	if commitAuthor, ok := d.GetOk("commit_author"); ok {
+		author := &github.CommitAuthor{
+			Name:  github.String(commitAuthor.(string)),
+			Email: github.String(d.Get("commit_email").(string)),
+		}
+		opts.Author = author
+		opts.Committer = author
+	}
+
+	return opts
+}
+
+// putWorkflowTemplateFile creates or, when sha is set, updates one of the
+// template's files and returns the blob SHA of the new content.
+func putWorkflowTemplateFile(ctx context.Context, d *schema.ResourceData, meta interface{}, path string, content []byte, sha, verb string) (string, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repo := d.Get("repository").(string)
+
+	opts := workflowTemplateFileOptions(d, path, verb)
+	opts.Content = content
+	if sha != "" {
+		opts.SHA = github.String(sha)
+	}
+
+	resp, _, err := client.Repositories.CreateFile(ctx, owner, repo, path, opts)
+	if err != nil {
+		return "", err
+	}
+	return resp.Content.GetSHA(), nil
+}
@@ -0,0 +1,132 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGithubActionsWorkflowTemplateProperties(t *testing.T) {
+
+	t.Run("renders the properties file", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceGithubActionsWorkflowTemplate().Schema, map[string]interface{}{
+			"name":          "go",
+			"workflow":      "jobs: {}",
+			"display_name":  "Go",
+			"description":   "Build and test a Go project.",
+			"categories":    []interface{}{"Go"},
+			"file_patterns": []interface{}{"go.mod"},
+		})
+
+		content, err := expandWorkflowTemplateProperties(d)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		expected := `{
+  "name": "Go",
+  "description": "Build and test a Go project.",
+  "categories": [
+    "Go"
+  ],
+  "filePatterns": [
+    "go.mod"
+  ]
+}
+`
+		if string(content) != expected {
+			t.Errorf("unexpected content:\n%s\nexpected:\n%s", content, expected)
+		}
+	})
+
+	t.Run("validates the workflow", func(t *testing.T) {
+		cases := map[string]bool{
+			"on: push\njobs:\n  build:\n    runs-on: ubuntu-latest\n": false,
+			"on: push\n": true,
+			"jobs: [":    true,
+		}
+		for workflow, expectError := range cases {
+			diags := validateWorkflowTemplateWorkflow(workflow, cty.Path{})
+			if diags.HasError() != expectError {
+				t.Errorf("unexpected diagnostics for %q: %v", workflow, diags)
+			}
+		}
+	})
+}
+
+func TestAccGithubActionsWorkflowTemplate(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("manages a workflow template", func(t *testing.T) {
+
+		config := `
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_actions_workflow_template" "test" {
+				repository   = github_repository.test.name
+				name         = "ci"
+				display_name = "%s"
+				categories   = ["Go"]
+
+				workflow = <<-EOT
+					on:
+					  push:
+					    branches: [$default-branch]
+					jobs:
+					  build:
+					    runs-on: ubuntu-latest
+					    steps:
+					      - uses: actions/checkout@v4
+				EOT
+			}
+		`
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, randomID, "CI"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_actions_workflow_template.test", "display_name", "CI"),
+							resource.TestCheckResourceAttrSet("github_actions_workflow_template.test", "workflow_sha"),
+							resource.TestCheckResourceAttrSet("github_actions_workflow_template.test", "properties_sha"),
+						),
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, "Continuous integration"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_actions_workflow_template.test", "display_name", "Continuous integration"),
+						),
+					},
+					{
+						ResourceName:      "github_actions_workflow_template.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -0,0 +1,284 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// advancedSecurityCommitterWindow is the period in which a user must have
+// pushed to a repository to count as an active committer.
+const advancedSecurityCommitterWindow = 90 * 24 * time.Hour
+
+func resourceGithubAdvancedSecurityCommittersBudget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubAdvancedSecurityCommittersBudgetCreate,
+		Read:   resourceGithubAdvancedSecurityCommittersBudgetRead,
+		Update: resourceGithubAdvancedSecurityCommittersBudgetUpdate,
+		Delete: resourceGithubAdvancedSecurityCommittersBudgetDelete,
+
+		CustomizeDiff: resourceGithubAdvancedSecurityCommittersBudgetCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"max_committers": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: toDiagFunc(validation.IntAtLeast(0), "max_committers"),
+				Description:      "The maximum number of active committers using GitHub Advanced Security across the organization.",
+			},
+			"repositories": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The names of the repositories to enable GitHub Advanced Security on.",
+			},
+			"active_committers": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of unique active committers using GitHub Advanced Security across the organization.",
+			},
+		},
+	}
+}
+
+// resourceGithubAdvancedSecurityCommittersBudgetCustomizeDiff fails the plan
+// if enabling GitHub Advanced Security on the added repositories would exceed
+// the budget. The committers a repository would add aren't known before it is
+// enabled, so they are estimated from the authors of its recent commits.
+func resourceGithubAdvancedSecurityCommittersBudgetCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("repositories") && !diff.HasChange("max_committers") {
+		return nil
+	}
+	if !diff.NewValueKnown("repositories") || !diff.NewValueKnown("max_committers") {
+		return nil
+	}
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	orgName := meta.(*Owner).name
+	maxCommitters := diff.Get("max_committers").(int)
+	o, n := diff.GetChange("repositories")
+	added := expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+	since := time.Now().Add(-advancedSecurityCommitterWindow)
+	committers, estimated, err := estimateAdvancedSecurityCommitters(ctx, meta, added, since)
+	if err != nil {
+		return err
+	}
+
+	if committers > maxCommitters {
+		return fmt.Errorf("enabling GitHub Advanced Security on %s would raise the active committers of %s to an estimated %d, "+
+			"exceeding the budget of %d", strings.Join(estimated, ", "), orgName, committers, maxCommitters)
+	}
+
+	return nil
+}
+
+// estimateAdvancedSecurityCommitters returns the estimated number of active
+// committers once GitHub Advanced Security is enabled on the given
+// repositories, along with the repositories that aren't enabled yet. Commits
+// authored before since are ignored.
+func estimateAdvancedSecurityCommitters(ctx context.Context, meta interface{}, repoNames []string, since time.Time) (int, []string, error) {
+	orgName := meta.(*Owner).name
+
+	committers, err := listAdvancedSecurityCommitters(ctx, meta)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	logins := make(map[string]bool)
+	enabled := make(map[string]bool)
+	for _, repo := range committers.Repositories {
+		enabled[strings.ToLower(repo.GetName())] = true
+		for _, committer := range repo.AdvancedSecurityCommittersBreakdown {
+			logins[strings.ToLower(committer.GetUserLogin())] = true
+		}
+	}
+
+	var estimated []string
+	for _, repoName := range repoNames {
+		if enabled[strings.ToLower(orgName+"/"+repoName)] {
+			continue
+		}
+
+		authors, err := listCommitAuthorsSince(ctx, meta, repoName, since)
+		if err != nil {
+			return 0, nil, err
+		}
+		for _, author := range authors {
+			logins[strings.ToLower(author)] = true
+		}
+		estimated = append(estimated, repoName)
+	}
+	sort.Strings(estimated)
+
+	return len(logins), estimated, nil
+}
+
+func resourceGithubAdvancedSecurityCommittersBudgetCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	for _, v := range d.Get("repositories").(*schema.Set).List() {
+		err = setRepositoryAdvancedSecurity(ctx, meta, v.(string), "enabled")
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(meta.(*Owner).name)
+
+	return resourceGithubAdvancedSecurityCommittersBudgetRead(d, meta)
+}
+
+func resourceGithubAdvancedSecurityCommittersBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	// Repositories that no longer exist or had GitHub Advanced Security
+	// disabled outside of Terraform are dropped, so they are enabled again.
+	repositories := make([]interface{}, 0)
+	for _, v := range d.Get("repositories").(*schema.Set).List() {
+		repo, _, err := client.Repositories.Get(ctx, orgName, v.(string))
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return err
+		}
+		if repo.GetSecurityAndAnalysis().GetAdvancedSecurity().GetStatus() == "enabled" {
+			repositories = append(repositories, v)
+		}
+	}
+
+	committers, err := listAdvancedSecurityCommitters(ctx, meta)
+	if err != nil {
+		return err
+	}
+
+	if err = d.Set("repositories", schema.NewSet(schema.HashString, repositories)); err != nil {
+		return err
+	}
+	return d.Set("active_committers", committers.TotalAdvancedSecurityCommitters)
+}
+
+func resourceGithubAdvancedSecurityCommittersBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	if d.HasChange("repositories") {
+		oldSet, newSet := setChanges(d.GetChange("repositories"))
+
+		for _, v := range oldSet.Difference(newSet).List() {
+			err = setRepositoryAdvancedSecurity(ctx, meta, v.(string), "disabled")
+			if err != nil {
+				return err
+			}
+		}
+		for _, v := range newSet.Difference(oldSet).List() {
+			err = setRepositoryAdvancedSecurity(ctx, meta, v.(string), "enabled")
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceGithubAdvancedSecurityCommittersBudgetRead(d, meta)
+}
+
+func resourceGithubAdvancedSecurityCommittersBudgetDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	for _, v := range d.Get("repositories").(*schema.Set).List() {
+		err = setRepositoryAdvancedSecurity(ctx, meta, v.(string), "disabled")
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setRepositoryAdvancedSecurity(ctx context.Context, meta interface{}, repoName, status string) error {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	log.Printf("[DEBUG] Setting GitHub Advanced Security of repository %s/%s to %s", orgName, repoName, status)
+	_, _, err := client.Repositories.Edit(ctx, orgName, repoName, &github.Repository{
+		SecurityAndAnalysis: &github.SecurityAndAnalysis{
+			AdvancedSecurity: &github.AdvancedSecurity{Status: github.String(status)},
+		},
+	})
+	return err
+}
+
+// listCommitAuthorsSince returns the logins of the authors of the commits on
+// the default branch of the repository since the given time.
+func listCommitAuthorsSince(ctx context.Context, meta interface{}, repoName string, since time.Time) ([]string, error) {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	options := &github.CommitsListOptions{
+		Since:       since,
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+
+	var authors []string
+	for {
+		commits, resp, err := client.Repositories.ListCommits(ctx, orgName, repoName, options)
+		if err != nil {
+			// Empty repositories have no commits to list.
+			if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusConflict {
+				return authors, nil
+			}
+			return nil, err
+		}
+
+		for _, commit := range commits {
+			if login := commit.GetAuthor().GetLogin(); login != "" {
+				authors = append(authors, login)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return authors, nil
+}
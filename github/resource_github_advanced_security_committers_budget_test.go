@@ -0,0 +1,54 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v65/github"
+)
+
+func TestEstimateAdvancedSecurityCommitters(t *testing.T) {
+	t.Run("adds the recent commit authors of repositories not enabled yet", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/settings/billing/advanced-security?per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody: `{"total_advanced_security_committers": 2, "repositories": [
+					{"name": "example/enabled", "advanced_security_committers": 2, "advanced_security_committers_breakdown": [
+						{"user_login": "alice"}, {"user_login": "bob"}
+					]}
+				]}`,
+				StatusCode: http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/repos/example/new/commits?per_page=100&since=2024-01-01T00%3A00%3A00Z",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"author": {"login": "Bob"}}, {"author": {"login": "carol"}}, {"author": null}]`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+		since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		committers, estimated, err := estimateAdvancedSecurityCommitters(context.Background(), meta, []string{"enabled", "new"}, since)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if committers != 3 {
+			t.Errorf("Expected 3 committers, got %d", committers)
+		}
+		if len(estimated) != 1 || estimated[0] != "new" {
+			t.Errorf("Expected only new to be estimated, got %v", estimated)
+		}
+	})
+}
@@ -21,6 +21,15 @@ func resourceGithubBranchProtectionV3() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceGithubBranchProtectionV3V0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceGithubBranchProtectionV3UpgradeV0,
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"repository": {
 				Type:        schema.TypeString,
@@ -65,13 +74,35 @@ func resourceGithubBranchProtectionV3() *schema.Resource {
 							},
 						},
 						"checks": {
-							Type:        schema.TypeSet,
-							Optional:    true,
-							Description: "The list of status checks to require in order to merge into this branch. No status checks are required by default. Checks should be strings containing the 'context' and 'app_id' like so 'context:app_id'",
+							Type:          schema.TypeSet,
+							Optional:      true,
+							Deprecated:    "Parsing 'context:app_id' strings is ambiguous for contexts containing colons. Use `required_check` blocks instead.",
+							ConflictsWith: []string{"required_status_checks.0.required_check"},
+							Description:   "The list of status checks to require in order to merge into this branch. No status checks are required by default. Checks should be strings containing the 'context' and 'app_id' like so 'context:app_id'",
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
 						},
+						"required_check": {
+							Type:          schema.TypeSet,
+							Optional:      true,
+							ConflictsWith: []string{"required_status_checks.0.checks"},
+							Description:   "A status check to require in order to merge into this branch.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"context": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the status check context.",
+									},
+									"integration_id": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "The ID of the GitHub App that must provide this check. Use '-1' to allow checks from any app.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
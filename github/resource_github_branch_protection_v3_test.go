@@ -230,6 +230,83 @@ func TestAccGithubBranchProtectionV3_required_status_checks(t *testing.T) {
 	})
 }
 
+func TestAccGithubBranchProtectionV3_required_status_check_blocks(t *testing.T) {
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("configures required status checks using blocks", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+
+			resource "github_repository" "test" {
+			  name      = "tf-acc-test-%s"
+			  auto_init = true
+			}
+
+			resource "github_branch_protection_v3" "test" {
+
+			  repository  = github_repository.test.name
+			  branch      = "main"
+
+			  required_status_checks {
+			    strict = true
+
+			    required_check {
+			      context = "github/foo"
+			    }
+
+			    required_check {
+			      context        = "github:foo:baz"
+			      integration_id = -1
+			    }
+			  }
+
+			}
+
+	`, randomID)
+
+		check := resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr(
+				"github_branch_protection_v3.test", "required_status_checks.#", "1",
+			),
+			resource.TestCheckResourceAttr(
+				"github_branch_protection_v3.test", "required_status_checks.0.required_check.#", "2",
+			),
+			resource.TestCheckTypeSetElemNestedAttrs(
+				"github_branch_protection_v3.test", "required_status_checks.0.required_check.*", map[string]string{
+					"context":        "github:foo:baz",
+					"integration_id": "-1",
+				},
+			),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
+
 func TestAccGithubBranchProtectionV3_required_status_contexts(t *testing.T) {
 	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
 
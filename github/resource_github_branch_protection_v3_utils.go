@@ -55,22 +55,38 @@ func flattenAndSetRequiredStatusChecks(d *schema.ResourceData, protection *githu
 			contexts = append(contexts, c)
 		}
 
+		// Keep using the legacy string form only while it is what the state holds
+		legacyChecks := false
+		if v, ok := d.GetOk("required_status_checks.0.checks"); ok {
+			legacyChecks = v.(*schema.Set).Len() > 0
+		}
+
 		// Flatten checks
+		var requiredChecks []interface{}
 		for _, chk := range *rsc.Checks {
-			// Parse into checks
-			if chk.AppID != nil {
-				checks = append(checks, fmt.Sprintf("%s:%d", chk.Context, *chk.AppID))
-			} else {
-				checks = append(checks, chk.Context)
+			if legacyChecks {
+				// Parse into checks
+				if chk.AppID != nil {
+					checks = append(checks, fmt.Sprintf("%s:%d", chk.Context, *chk.AppID))
+				} else {
+					checks = append(checks, chk.Context)
+				}
+				continue
 			}
+
+			requiredChecks = append(requiredChecks, map[string]interface{}{
+				"context":        chk.Context,
+				"integration_id": int(chk.GetAppID()),
+			})
 		}
 
 		return d.Set("required_status_checks", []interface{}{
 			map[string]interface{}{
 				"strict": rsc.Strict,
 				// TODO: Remove once contexts is fully deprecated.
-				"contexts": schema.NewSet(schema.HashString, contexts),
-				"checks":   schema.NewSet(schema.HashString, checks),
+				"contexts":       schema.NewSet(schema.HashString, contexts),
+				"checks":         schema.NewSet(schema.HashString, checks),
+				"required_check": requiredChecks,
 			},
 		})
 	}
@@ -275,34 +291,25 @@ func expandRequiredStatusChecks(d *schema.ResourceData) (*github.RequiredStatusC
 			// Iterate and parse checks
 			checks := expandNestedSet(m, "checks")
 			for _, c := range checks {
-
-				// Expect a string of "context:app_id", allowing for the absence of "app_id"
-				index := strings.LastIndex(c, ":")
-				var cContext, cAppId string
-				if index <= 0 {
-					// If there is no ":" or it's in the first position, there is no app_id.
-					cContext, cAppId = c, ""
-				} else {
-					cContext, cAppId = c[:index], c[index+1:]
+				rscCheck, err := parseRequiredStatusCheck(c)
+				if err != nil {
+					return nil, err
 				}
+				rscChecks = append(rscChecks, rscCheck)
+			}
 
-				var rscCheck *github.RequiredStatusCheck
-				if cAppId != "" {
-					// If we have a valid app_id, include it in the RSC
-					rscAppId, err := strconv.Atoi(cAppId)
-					if err != nil {
-						return nil, fmt.Errorf("could not parse %v as valid app_id", cAppId)
+			// Iterate required_check blocks, which carry the context and app_id separately
+			if v, ok := m["required_check"]; ok {
+				for _, rc := range v.(*schema.Set).List() {
+					rcMap := rc.(map[string]interface{})
+					rscCheck := &github.RequiredStatusCheck{Context: rcMap["context"].(string)}
+					if appID := int64(rcMap["integration_id"].(int)); appID != 0 {
+						rscCheck.AppID = &appID
 					}
-					rscAppId64 := int64(rscAppId)
-					rscCheck = &github.RequiredStatusCheck{Context: cContext, AppID: &rscAppId64}
-				} else {
-					// Else simply provide the context
-					rscCheck = &github.RequiredStatusCheck{Context: cContext}
+					rscChecks = append(rscChecks, rscCheck)
 				}
-
-				// Append
-				rscChecks = append(rscChecks, rscCheck)
 			}
+
 			// Assign after looping both checks and contexts
 			rsc.Checks = &rscChecks
 		}
@@ -312,6 +319,24 @@ func expandRequiredStatusChecks(d *schema.ResourceData) (*github.RequiredStatusC
 	return nil, nil
 }
 
+// parseRequiredStatusCheck parses a legacy "context:app_id" check string,
+// allowing for the absence of "app_id".
+func parseRequiredStatusCheck(c string) (*github.RequiredStatusCheck, error) {
+	index := strings.LastIndex(c, ":")
+	if index <= 0 {
+		// If there is no ":" or it's in the first position, there is no app_id.
+		return &github.RequiredStatusCheck{Context: c}, nil
+	}
+
+	cContext, cAppId := c[:index], c[index+1:]
+	rscAppId, err := strconv.Atoi(cAppId)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %v as valid app_id", cAppId)
+	}
+	rscAppId64 := int64(rscAppId)
+	return &github.RequiredStatusCheck{Context: cContext, AppID: &rscAppId64}, nil
+}
+
 func expandRequiredPullRequestReviews(d *schema.ResourceData) (*github.PullRequestReviewsEnforcementRequest, error) {
 	if v, ok := d.GetOk("required_pull_request_reviews"); ok {
 		vL := v.([]interface{})
@@ -0,0 +1,147 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubCodeScanningDefaultSetup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubCodeScanningDefaultSetupCreateOrUpdate,
+		Read:   resourceGithubCodeScanningDefaultSetupRead,
+		Update: resourceGithubCodeScanningDefaultSetupCreateOrUpdate,
+		Delete: resourceGithubCodeScanningDefaultSetupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "configured",
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"configured", "not-configured"}, false), "state"),
+				Description:      "Whether code scanning default setup is 'configured' or 'not-configured'.",
+			},
+			"query_suite": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"default", "extended"}, false), "query_suite"),
+				Description:      "The CodeQL query suite to use. Can be 'default' or 'extended'.",
+			},
+			"languages": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The languages to analyze. If not set, the languages detected in the repository are analyzed.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the default setup was last updated.",
+			},
+		},
+	}
+}
+
+func resourceGithubCodeScanningDefaultSetupCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	options := &github.UpdateDefaultSetupConfigurationOptions{
+		State: d.Get("state").(string),
+	}
+	if v, ok := d.GetOk("query_suite"); ok {
+		options.QuerySuite = github.String(v.(string))
+	}
+	if v, ok := d.GetOk("languages"); ok {
+		options.Languages = expandStringList(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Setting code scanning default setup of repository %s/%s to %s", owner, repoName, options.State)
+	_, _, err := client.CodeScanning.UpdateDefaultSetupConfiguration(ctx, owner, repoName, options)
+	// The setup is applied in the background, which GitHub reports with a 202.
+	// Reading it back right away could return the previous configuration.
+	if _, ok := err.(*github.AcceptedError); !ok && err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+
+	return nil
+}
+
+func resourceGithubCodeScanningDefaultSetupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	config, _, err := client.CodeScanning.GetDefaultSetupConfiguration(ctx, owner, repoName)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing code scanning default setup of repository %s/%s from state because the repository no longer exists in GitHub",
+					owner, repoName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	if err = d.Set("repository", repoName); err != nil {
+		return err
+	}
+	if err = d.Set("state", config.GetState()); err != nil {
+		return err
+	}
+	if err = d.Set("query_suite", config.GetQuerySuite()); err != nil {
+		return err
+	}
+	if err = d.Set("languages", config.Languages); err != nil {
+		return err
+	}
+	return d.Set("updated_at", config.GetUpdatedAt().String())
+}
+
+func resourceGithubCodeScanningDefaultSetupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Disabling code scanning default setup of repository %s/%s", owner, repoName)
+	_, _, err := client.CodeScanning.UpdateDefaultSetupConfiguration(ctx, owner, repoName, &github.UpdateDefaultSetupConfigurationOptions{
+		State: "not-configured",
+	})
+	if _, ok := err.(*github.AcceptedError); ok {
+		return nil
+	}
+	if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
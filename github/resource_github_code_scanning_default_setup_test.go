@@ -0,0 +1,81 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubCodeScanningDefaultSetup(t *testing.T) {
+	t.Run("configures default setup in the background", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo/code-scanning/default-setup",
+				ExpectedMethod: "PATCH",
+				ExpectedBody:   []byte(`{"state":"configured","query_suite":"extended","languages":["python"]}` + "\n"),
+				ResponseBody:   `{"run_id": 42}`,
+				StatusCode:     http.StatusAccepted,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubCodeScanningDefaultSetup().Schema, map[string]interface{}{
+			"repository":  "repo",
+			"query_suite": "extended",
+			"languages":   []interface{}{"python"},
+		})
+
+		err := resourceGithubCodeScanningDefaultSetupCreateOrUpdate(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "repo" {
+			t.Errorf("Unexpected ID %q", d.Id())
+		}
+	})
+
+	t.Run("reads the default setup", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo/code-scanning/default-setup",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"state": "configured", "query_suite": "default", "languages": ["go", "javascript-typescript"]}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubCodeScanningDefaultSetup().Schema, map[string]interface{}{
+			"repository": "repo",
+		})
+		d.SetId("repo")
+
+		err := resourceGithubCodeScanningDefaultSetupRead(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if v := d.Get("query_suite").(string); v != "default" {
+			t.Errorf("Unexpected query suite %q", v)
+		}
+		if n := d.Get("languages").(*schema.Set).Len(); n != 2 {
+			t.Errorf("Expected 2 languages, got %d", n)
+		}
+	})
+}
@@ -0,0 +1,173 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The Codespaces access endpoints are not supported by go-github yet, and the
+// API offers no way to read the current setting back, so the resource keeps
+// the configured values in state.
+
+type codespacesOrganizationAccess struct {
+	Visibility        string   `json:"visibility"`
+	SelectedUsernames []string `json:"selected_usernames,omitempty"`
+}
+
+type codespacesOrganizationAccessUsers struct {
+	SelectedUsernames []string `json:"selected_usernames"`
+}
+
+func resourceGithubCodespacesOrganizationAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubCodespacesOrganizationAccessCreate,
+		Read:   resourceGithubCodespacesOrganizationAccessRead,
+		Update: resourceGithubCodespacesOrganizationAccessUpdate,
+		Delete: resourceGithubCodespacesOrganizationAccessDelete,
+
+		Schema: map[string]*schema.Schema{
+			"visibility": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+					"disabled", "selected_members", "all_members", "all_members_and_outside_collaborators",
+				}, false), "visibility"),
+				Description: "Which members of the organization can use Codespaces billed to the organization. " +
+					"Can be one of 'disabled', 'selected_members', 'all_members' or 'all_members_and_outside_collaborators'.",
+			},
+			"selected_usernames": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The usernames of the organization members that can use Codespaces. Only valid when 'visibility' is 'selected_members'.",
+			},
+		},
+
+		CustomizeDiff: resourceGithubCodespacesOrganizationAccessCustomizeDiff,
+	}
+}
+
+func resourceGithubCodespacesOrganizationAccessCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	usernames := diff.Get("selected_usernames").(*schema.Set)
+	if diff.Get("visibility").(string) != "selected_members" && usernames.Len() > 0 {
+		return fmt.Errorf("selected_usernames can only be set when visibility is %q", "selected_members")
+	}
+	return nil
+}
+
+func resourceGithubCodespacesOrganizationAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	access := &codespacesOrganizationAccess{
+		Visibility:        d.Get("visibility").(string),
+		SelectedUsernames: expandStringList(d.Get("selected_usernames").(*schema.Set).List()),
+	}
+	err = setCodespacesOrganizationAccess(ctx, client, orgName, access)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(orgName)
+	return resourceGithubCodespacesOrganizationAccessRead(d, meta)
+}
+
+func resourceGithubCodespacesOrganizationAccessRead(d *schema.ResourceData, meta interface{}) error {
+	return checkOrganization(meta)
+}
+
+func resourceGithubCodespacesOrganizationAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := d.Id()
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	if d.HasChange("visibility") {
+		access := &codespacesOrganizationAccess{
+			Visibility:        d.Get("visibility").(string),
+			SelectedUsernames: expandStringList(d.Get("selected_usernames").(*schema.Set).List()),
+		}
+		err = setCodespacesOrganizationAccess(ctx, client, orgName, access)
+		if err != nil {
+			return err
+		}
+		return resourceGithubCodespacesOrganizationAccessRead(d, meta)
+	}
+
+	// Only the selected members changed, so add and remove the difference
+	// rather than replacing the whole list.
+	o, n := d.GetChange("selected_usernames")
+	oldUsernames := o.(*schema.Set)
+	newUsernames := n.(*schema.Set)
+
+	removed := expandStringList(oldUsernames.Difference(newUsernames).List())
+	if len(removed) > 0 {
+		log.Printf("[DEBUG] Removing %d users from Codespaces access of organization %s", len(removed), orgName)
+		err = updateCodespacesOrganizationAccessUsers(ctx, client, orgName, "DELETE", removed)
+		if err != nil {
+			return err
+		}
+	}
+
+	added := expandStringList(newUsernames.Difference(oldUsernames).List())
+	if len(added) > 0 {
+		log.Printf("[DEBUG] Adding %d users to Codespaces access of organization %s", len(added), orgName)
+		err = updateCodespacesOrganizationAccessUsers(ctx, client, orgName, "POST", added)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubCodespacesOrganizationAccessRead(d, meta)
+}
+
+func resourceGithubCodespacesOrganizationAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Disabling Codespaces access of organization %s", d.Id())
+	return setCodespacesOrganizationAccess(ctx, client, d.Id(), &codespacesOrganizationAccess{Visibility: "disabled"})
+}
+
+func setCodespacesOrganizationAccess(ctx context.Context, client *github.Client, orgName string, access *codespacesOrganizationAccess) error {
+	req, err := client.NewRequest("PUT", fmt.Sprintf("orgs/%s/codespaces/access", orgName), access)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
+
+func updateCodespacesOrganizationAccessUsers(ctx context.Context, client *github.Client, orgName, method string, usernames []string) error {
+	req, err := client.NewRequest(method, fmt.Sprintf("orgs/%s/codespaces/access/selected_users", orgName), &codespacesOrganizationAccessUsers{
+		SelectedUsernames: usernames,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
@@ -0,0 +1,84 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubCodespacesOrganizationAccess(t *testing.T) {
+	t.Run("manages codespaces access for selected members", func(t *testing.T) {
+		if testCollaborator == "" {
+			t.Skip("Skipping because `GITHUB_TEST_COLLABORATOR` is not set")
+		}
+
+		configSelected := fmt.Sprintf(`
+			resource "github_codespaces_organization_access" "test" {
+				visibility         = "selected_members"
+				selected_usernames = ["%s"]
+			}
+		`, testCollaborator)
+
+		configAll := `
+			resource "github_codespaces_organization_access" "test" {
+				visibility = "all_members"
+			}
+		`
+
+		configInvalid := fmt.Sprintf(`
+			resource "github_codespaces_organization_access" "test" {
+				visibility         = "all_members"
+				selected_usernames = ["%s"]
+			}
+		`, testCollaborator)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: configSelected,
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(
+								"github_codespaces_organization_access.test", "visibility", "selected_members",
+							),
+							resource.TestCheckResourceAttr(
+								"github_codespaces_organization_access.test", "selected_usernames.#", "1",
+							),
+						),
+					},
+					{
+						Config: configAll,
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(
+								"github_codespaces_organization_access.test", "visibility", "all_members",
+							),
+							resource.TestCheckResourceAttr(
+								"github_codespaces_organization_access.test", "selected_usernames.#", "0",
+							),
+						),
+					},
+					{
+						Config:      configInvalid,
+						ExpectError: regexp.MustCompile(`selected_usernames can only be set`),
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -0,0 +1,74 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubCodespacesOrganizationSecretRepository(t *testing.T) {
+	const ORG_SECRET_NAME = "ORG_SECRET_NAME"
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	secret_name, exists := os.LookupEnv(ORG_SECRET_NAME)
+
+	t.Run("add a single repository to an organization secret", func(t *testing.T) {
+		if !exists {
+			t.Skipf("%s environment variable is missing", ORG_SECRET_NAME)
+		}
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name = "tf-acc-test-%s"
+				visibility = "internal"
+			}
+
+			resource "github_codespaces_organization_secret_repository" "test" {
+				secret_name   = "%s"
+				repository_id = github_repository.test.repo_id
+			}
+		`, randomID, secret_name)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(
+				"github_codespaces_organization_secret_repository.test", "secret_name", secret_name,
+			),
+			resource.TestCheckResourceAttrPair(
+				"github_codespaces_organization_secret_repository.test", "repository_id",
+				"github_repository.test", "repo_id",
+			),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+					{
+						ResourceName:      "github_codespaces_organization_secret_repository.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
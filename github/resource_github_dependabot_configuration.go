@@ -0,0 +1,713 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+)
+
+const dependabotConfigurationPath = ".github/dependabot.yml"
+
+var timeOfDayRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// dependabotConfig mirrors the structure of dependabot.yml. Field order
+// follows the order GitHub uses in its documentation so that the rendered
+// file reads naturally.
+type dependabotConfig struct {
+	Version    int                           `yaml:"version"`
+	Registries map[string]dependabotRegistry `yaml:"registries,omitempty"`
+	Updates    []dependabotUpdate            `yaml:"updates"`
+}
+
+type dependabotRegistry struct {
+	Type         string `yaml:"type"`
+	URL          string `yaml:"url,omitempty"`
+	Username     string `yaml:"username,omitempty"`
+	Password     string `yaml:"password,omitempty"`
+	Key          string `yaml:"key,omitempty"`
+	Token        string `yaml:"token,omitempty"`
+	ReplacesBase bool   `yaml:"replaces-base,omitempty"`
+}
+
+type dependabotUpdate struct {
+	PackageEcosystem      string                     `yaml:"package-ecosystem"`
+	Directory             string                     `yaml:"directory,omitempty"`
+	Directories           []string                   `yaml:"directories,omitempty"`
+	Schedule              dependabotSchedule         `yaml:"schedule"`
+	TargetBranch          string                     `yaml:"target-branch,omitempty"`
+	OpenPullRequestsLimit *int                       `yaml:"open-pull-requests-limit,omitempty"`
+	VersioningStrategy    string                     `yaml:"versioning-strategy,omitempty"`
+	Registries            []string                   `yaml:"registries,omitempty"`
+	Labels                []string                   `yaml:"labels,omitempty"`
+	Assignees             []string                   `yaml:"assignees,omitempty"`
+	Allow                 []dependabotAllow          `yaml:"allow,omitempty"`
+	Ignore                []dependabotIgnore         `yaml:"ignore,omitempty"`
+	Groups                map[string]dependabotGroup `yaml:"groups,omitempty"`
+	CommitMessage         *dependabotCommitMessage   `yaml:"commit-message,omitempty"`
+}
+
+type dependabotSchedule struct {
+	Interval string `yaml:"interval"`
+	Day      string `yaml:"day,omitempty"`
+	Time     string `yaml:"time,omitempty"`
+	Timezone string `yaml:"timezone,omitempty"`
+}
+
+type dependabotAllow struct {
+	DependencyName string `yaml:"dependency-name,omitempty"`
+	DependencyType string `yaml:"dependency-type,omitempty"`
+}
+
+type dependabotIgnore struct {
+	DependencyName string   `yaml:"dependency-name"`
+	Versions       []string `yaml:"versions,omitempty"`
+	UpdateTypes    []string `yaml:"update-types,omitempty"`
+}
+
+type dependabotGroup struct {
+	AppliesTo       string   `yaml:"applies-to,omitempty"`
+	DependencyType  string   `yaml:"dependency-type,omitempty"`
+	Patterns        []string `yaml:"patterns,omitempty"`
+	ExcludePatterns []string `yaml:"exclude-patterns,omitempty"`
+	UpdateTypes     []string `yaml:"update-types,omitempty"`
+}
+
+type dependabotCommitMessage struct {
+	Prefix            string `yaml:"prefix,omitempty"`
+	PrefixDevelopment string `yaml:"prefix-development,omitempty"`
+	Include           string `yaml:"include,omitempty"`
+}
+
+func resourceGithubDependabotConfiguration() *schema.Resource {
+	stringList := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: description,
+		}
+	}
+
+	updateTypes := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+				"version-update:semver-major", "version-update:semver-minor", "version-update:semver-patch",
+			}, false), "update_types"),
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceGithubDependabotConfigurationCreate,
+		Read:   resourceGithubDependabotConfigurationRead,
+		Update: resourceGithubDependabotConfigurationUpdate,
+		Delete: resourceGithubDependabotConfigurationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The repository name.",
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The branch to commit the configuration to, defaults to the repository's default branch.",
+			},
+			"registry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "A private registry that Dependabot can access.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name used to refer to the registry from 'update' blocks.",
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+								"composer-repository", "docker-registry", "git", "hex-organization", "hex-repository",
+								"maven-repository", "npm-registry", "nuget-feed", "python-index", "rubygems-server",
+								"terraform-registry",
+							}, false), "type"),
+							Description: "The type of the registry.",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The URL of the registry.",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The username Dependabot uses to access the registry.",
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A reference to the Dependabot secret holding the password, e.g. '${{secrets.MY_PASSWORD}}'.",
+						},
+						"key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A reference to the Dependabot secret holding the access key.",
+						},
+						"token": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A reference to the Dependabot secret holding the access token.",
+						},
+						"replaces_base": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether Dependabot resolves dependencies from this registry instead of the ecosystem's default registry.",
+						},
+					},
+				},
+			},
+			"update": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "How Dependabot updates the dependencies of one package ecosystem.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"package_ecosystem": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+								"bundler", "cargo", "composer", "devcontainers", "docker", "elm", "gitsubmodule",
+								"github-actions", "gomod", "gradle", "maven", "mix", "npm", "nuget", "pip", "pub",
+								"swift", "terraform",
+							}, false), "package_ecosystem"),
+							Description: "The package manager to update dependencies for.",
+						},
+						"directory": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The location of the package manifests. Conflicts with 'directories'.",
+						},
+						"directories": stringList("The locations of the package manifests. Conflicts with 'directory'."),
+						"schedule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"interval": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"daily", "weekly", "monthly"}, false), "interval"),
+									},
+									"day": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+											"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday",
+										}, false), "day"),
+										Description: "The day of the week to check for updates. Only valid with a weekly interval.",
+									},
+									"time": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: toDiagFunc(validation.StringMatch(timeOfDayRegexp, "must be formatted as hh:mm"), "time"),
+										Description:      "The time of day to check for updates, formatted as 'hh:mm'.",
+									},
+									"timezone": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The time zone of 'time', e.g. 'Europe/Stockholm'. Defaults to UTC.",
+									},
+								},
+							},
+						},
+						"target_branch": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The branch to check for manifest files and open pull requests against.",
+						},
+						"open_pull_requests_limit": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          -1,
+							ValidateDiagFunc: toDiagFunc(validation.IntAtLeast(-1), "open_pull_requests_limit"),
+							Description:      "The maximum number of open pull requests for version updates. '0' disables version updates. Omitted from the file when '-1'.",
+						},
+						"versioning_strategy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+								"auto", "increase", "increase-if-necessary", "lockfile-only", "widen",
+							}, false), "versioning_strategy"),
+						},
+						"registries": stringList("The names of the registries Dependabot can use for this ecosystem, or '*' for all of them."),
+						"labels":     stringList("The labels of the pull requests."),
+						"assignees":  stringList("The users assigned to the pull requests."),
+						"allow": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Which dependencies to update. All dependencies are updated if omitted.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dependency_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"dependency_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+											"direct", "indirect", "all", "production", "development",
+										}, false), "dependency_type"),
+									},
+								},
+							},
+						},
+						"ignore": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Dependencies or versions to ignore.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dependency_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"versions":     stringList("The versions or ranges of versions to ignore."),
+									"update_types": updateTypes,
+								},
+							},
+						},
+						"group": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Dependencies to update together in a single pull request.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"applies_to": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"version-updates", "security-updates"}, false), "applies_to"),
+									},
+									"dependency_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"production", "development"}, false), "dependency_type"),
+									},
+									"patterns":         stringList("The dependency names to include in the group."),
+									"exclude_patterns": stringList("The dependency names to exclude from the group."),
+									"update_types":     updateTypes,
+								},
+							},
+						},
+						"commit_message": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"prefix_development": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"include": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"scope"}, false), "include"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rendered content of the configuration file.",
+			},
+			"commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit message when creating, updating or deleting the file.",
+			},
+			"commit_author": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"commit_email"},
+				Description:  "The commit author name, defaults to the authenticated user's name.",
+			},
+			"commit_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"commit_author"},
+				Description:  "The commit author email address, defaults to the authenticated user's email address.",
+			},
+			"overwrite_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable overwriting an existing configuration file.",
+			},
+			"sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The blob SHA of the file.",
+			},
+			"commit_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the commit that last modified the file.",
+			},
+		},
+
+		CustomizeDiff: resourceGithubDependabotConfigurationDiff,
+	}
+}
+
+// resourceGithubDependabotConfigurationDiff validates the configuration and
+// renders it at plan time, so that both mistakes and the resulting file show
+// up before anything is committed.
+func resourceGithubDependabotConfigurationDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	plan := diff.GetRawPlan()
+	if !plan.IsNull() && (!plan.GetAttr("update").IsWhollyKnown() || !plan.GetAttr("registry").IsWhollyKnown()) {
+		return diff.SetNewComputed("content")
+	}
+
+	content, err := renderDependabotConfiguration(diff.Get("update").([]interface{}), diff.Get("registry").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if diff.Get("content").(string) != content {
+		return diff.SetNew("content", content)
+	}
+	return nil
+}
+
+func resourceGithubDependabotConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	repo := d.Get("repository").(string)
+
+	opts, err := dependabotConfigurationFileOptions(d, "Add")
+	if err != nil {
+		return err
+	}
+
+	checkOpt := &github.RepositoryContentGetOptions{}
+	if branch, ok := d.GetOk("branch"); ok {
+		err = checkRepositoryBranchExists(client, owner, repo, branch.(string))
+		if err != nil {
+			return err
+		}
+		checkOpt.Ref = branch.(string)
+	}
+
+	fileContent, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, dependabotConfigurationPath, checkOpt)
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		return err
+	}
+	if fileContent != nil {
+		if !d.Get("overwrite_on_create").(bool) {
+			return fmt.Errorf("refusing to overwrite existing %s: configure `overwrite_on_create` to `true` to override", dependabotConfigurationPath)
+		}
+		opts.SHA = fileContent.SHA
+	}
+
+	log.Printf("[DEBUG] Creating Dependabot configuration: %s/%s", owner, repo)
+	create, _, err := client.Repositories.CreateFile(ctx, owner, repo, dependabotConfigurationPath, opts)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repo)
+	if err = d.Set("commit_sha", create.Commit.GetSHA()); err != nil {
+		return err
+	}
+
+	return resourceGithubDependabotConfigurationRead(d, meta)
+}
+
+func resourceGithubDependabotConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repo := d.Get("repository").(string)
+
+	opts := &github.RepositoryContentGetOptions{}
+	if branch, ok := d.GetOk("branch"); ok {
+		opts.Ref = branch.(string)
+	}
+
+	fc, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, dependabotConfigurationPath, opts)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[INFO] Removing Dependabot configuration %s/%s from state because it no longer exists in GitHub",
+				owner, repo)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	content, err := fc.GetContent()
+	if err != nil {
+		return err
+	}
+
+	// Changes made to the file outside of Terraform show up as a difference
+	// between this content and the one rendered from the configuration.
+	if err = d.Set("content", content); err != nil {
+		return err
+	}
+	return d.Set("sha", fc.GetSHA())
+}
+
+func resourceGithubDependabotConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repo := d.Get("repository").(string)
+
+	opts, err := dependabotConfigurationFileOptions(d, "Update")
+	if err != nil {
+		return err
+	}
+	opts.SHA = github.String(d.Get("sha").(string))
+
+	log.Printf("[DEBUG] Updating Dependabot configuration: %s/%s", owner, repo)
+	update, _, err := client.Repositories.CreateFile(ctx, owner, repo, dependabotConfigurationPath, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = d.Set("commit_sha", update.Commit.GetSHA()); err != nil {
+		return err
+	}
+
+	return resourceGithubDependabotConfigurationRead(d, meta)
+}
+
+func resourceGithubDependabotConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repo := d.Get("repository").(string)
+
+	opts, err := dependabotConfigurationFileOptions(d, "Delete")
+	if err != nil {
+		return err
+	}
+	opts.Content = nil
+	opts.SHA = github.String(d.Get("sha").(string))
+
+	log.Printf("[DEBUG] Deleting Dependabot configuration: %s/%s", owner, repo)
+	_, _, err = client.Repositories.DeleteFile(ctx, owner, repo, dependabotConfigurationPath, opts)
+	return err
+}
+
+// dependabotConfigurationFileOptions renders the configuration and returns
+// the options to commit it with, using "<verb> .github/dependabot.yml" as
+// the default commit message.
+func dependabotConfigurationFileOptions(d *schema.ResourceData, verb string) (*github.RepositoryContentFileOptions, error) {
+	content, err := renderDependabotConfiguration(d.Get("update").([]interface{}), d.Get("registry").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("%s %s", verb, dependabotConfigurationPath)
+	if commitMessage, ok := d.GetOk("commit_message"); ok {
+		message = commitMessage.(string)
+	}
+
+	opts := &github.RepositoryContentFileOptions{
+		Content: []byte(content),
+		Message: github.String(message),
+	}
+
+	if branch, ok := d.GetOk("branch"); ok {
+		opts.Branch = github.String(branch.(string))
+	}
+
+	if commitAuthor, ok := d.GetOk("commit_author"); ok {
+		author := &github.CommitAuthor{
+			Name:  github.String(commitAuthor.(string)),
+			Email: github.String(d.Get("commit_email").(string)),
+		}
+		opts.Author = author
+		opts.Committer = author
+	}
+
+	return opts, nil
+}
+
+// renderDependabotConfiguration validates the update and registry blocks and
+// renders them to the YAML content of dependabot.yml.
+func renderDependabotConfiguration(updates []interface{}, registries []interface{}) (string, error) {
+	config, err := expandDependabotConfiguration(updates, registries)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(config); err != nil {
+		return "", err
+	}
+	if err = encoder.Close(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func expandDependabotConfiguration(updates []interface{}, registries []interface{}) (*dependabotConfig, error) {
+	config := &dependabotConfig{Version: 2}
+
+	for _, v := range registries {
+		m := v.(map[string]interface{})
+		name := m["name"].(string)
+		if _, ok := config.Registries[name]; ok {
+			return nil, fmt.Errorf("registry %q is declared more than once", name)
+		}
+		if config.Registries == nil {
+			config.Registries = make(map[string]dependabotRegistry)
+		}
+		config.Registries[name] = dependabotRegistry{
+			Type:         m["type"].(string),
+			URL:          m["url"].(string),
+			Username:     m["username"].(string),
+			Password:     m["password"].(string),
+			Key:          m["key"].(string),
+			Token:        m["token"].(string),
+			ReplacesBase: m["replaces_base"].(bool),
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, v := range updates {
+		m := v.(map[string]interface{})
+		update := dependabotUpdate{
+			PackageEcosystem:   m["package_ecosystem"].(string),
+			Directory:          m["directory"].(string),
+			Directories:        expandStringList(m["directories"].([]interface{})),
+			TargetBranch:       m["target_branch"].(string),
+			VersioningStrategy: m["versioning_strategy"].(string),
+			Registries:         expandStringList(m["registries"].([]interface{})),
+			Labels:             expandStringList(m["labels"].([]interface{})),
+			Assignees:          expandStringList(m["assignees"].([]interface{})),
+		}
+
+		if (update.Directory == "") == (len(update.Directories) == 0) {
+			return nil, fmt.Errorf("update for %s must set exactly one of directory or directories", update.PackageEcosystem)
+		}
+
+		key := strings.Join([]string{update.PackageEcosystem, update.Directory, strings.Join(update.Directories, ","), update.TargetBranch}, "|")
+		if seen[key] {
+			return nil, fmt.Errorf("update for %s is declared more than once for the same directories and target branch", update.PackageEcosystem)
+		}
+		seen[key] = true
+
+		if schedule := m["schedule"].([]interface{}); len(schedule) > 0 && schedule[0] != nil {
+			s := schedule[0].(map[string]interface{})
+			update.Schedule = dependabotSchedule{
+				Interval: s["interval"].(string),
+				Day:      s["day"].(string),
+				Time:     s["time"].(string),
+				Timezone: s["timezone"].(string),
+			}
+		}
+		if update.Schedule.Day != "" && update.Schedule.Interval != "weekly" {
+			return nil, fmt.Errorf("update for %s can only set schedule day with a weekly interval", update.PackageEcosystem)
+		}
+
+		if limit := m["open_pull_requests_limit"].(int); limit >= 0 {
+			update.OpenPullRequestsLimit = &limit
+		}
+
+		for _, name := range update.Registries {
+			if _, ok := config.Registries[name]; name != "*" && !ok {
+				return nil, fmt.Errorf("update for %s uses registry %q, which is not declared", update.PackageEcosystem, name)
+			}
+		}
+
+		for _, a := range m["allow"].([]interface{}) {
+			allow := a.(map[string]interface{})
+			update.Allow = append(update.Allow, dependabotAllow{
+				DependencyName: allow["dependency_name"].(string),
+				DependencyType: allow["dependency_type"].(string),
+			})
+		}
+
+		for _, i := range m["ignore"].([]interface{}) {
+			ignore := i.(map[string]interface{})
+			update.Ignore = append(update.Ignore, dependabotIgnore{
+				DependencyName: ignore["dependency_name"].(string),
+				Versions:       expandStringList(ignore["versions"].([]interface{})),
+				UpdateTypes:    expandStringList(ignore["update_types"].([]interface{})),
+			})
+		}
+
+		for _, g := range m["group"].([]interface{}) {
+			group := g.(map[string]interface{})
+			name := group["name"].(string)
+			if _, ok := update.Groups[name]; ok {
+				return nil, fmt.Errorf("update for %s declares group %q more than once", update.PackageEcosystem, name)
+			}
+			if update.Groups == nil {
+				update.Groups = make(map[string]dependabotGroup)
+			}
+			update.Groups[name] = dependabotGroup{
+				AppliesTo:       group["applies_to"].(string),
+				DependencyType:  group["dependency_type"].(string),
+				Patterns:        expandStringList(group["patterns"].([]interface{})),
+				ExcludePatterns: expandStringList(group["exclude_patterns"].([]interface{})),
+				UpdateTypes:     expandStringList(group["update_types"].([]interface{})),
+			}
+		}
+
+		if commitMessage := m["commit_message"].([]interface{}); len(commitMessage) > 0 && commitMessage[0] != nil {
+			c := commitMessage[0].(map[string]interface{})
+			update.CommitMessage = &dependabotCommitMessage{
+				Prefix:            c["prefix"].(string),
+				PrefixDevelopment: c["prefix_development"].(string),
+				Include:           c["include"].(string),
+			}
+		}
+
+		config.Updates = append(config.Updates, update)
+	}
+
+	return config, nil
+}
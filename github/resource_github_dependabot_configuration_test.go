@@ -0,0 +1,287 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestRenderDependabotConfiguration(t *testing.T) {
+
+	update := func(overrides map[string]interface{}) map[string]interface{} {
+		m := map[string]interface{}{
+			"package_ecosystem":        "gomod",
+			"directory":                "/",
+			"directories":              []interface{}{},
+			"schedule":                 []interface{}{map[string]interface{}{"interval": "weekly", "day": "monday", "time": "", "timezone": ""}},
+			"target_branch":            "",
+			"open_pull_requests_limit": -1,
+			"versioning_strategy":      "",
+			"registries":               []interface{}{},
+			"labels":                   []interface{}{},
+			"assignees":                []interface{}{},
+			"allow":                    []interface{}{},
+			"ignore":                   []interface{}{},
+			"group":                    []interface{}{},
+			"commit_message":           []interface{}{},
+		}
+		for k, v := range overrides {
+			m[k] = v
+		}
+		return m
+	}
+
+	registry := map[string]interface{}{
+		"name":          "npm-github",
+		"type":          "npm-registry",
+		"url":           "https://npm.pkg.github.com",
+		"username":      "",
+		"password":      "",
+		"key":           "",
+		"token":         "${{secrets.NPM_TOKEN}}",
+		"replaces_base": true,
+	}
+
+	t.Run("renders a configuration", func(t *testing.T) {
+		content, err := renderDependabotConfiguration([]interface{}{
+			update(nil),
+			update(map[string]interface{}{
+				"package_ecosystem":        "npm",
+				"directory":                "",
+				"directories":              []interface{}{"/web", "/docs"},
+				"schedule":                 []interface{}{map[string]interface{}{"interval": "daily", "day": "", "time": "09:00", "timezone": "Europe/Stockholm"}},
+				"open_pull_requests_limit": 0,
+				"registries":               []interface{}{"npm-github"},
+				"group": []interface{}{map[string]interface{}{
+					"name":             "eslint",
+					"applies_to":       "",
+					"dependency_type":  "",
+					"patterns":         []interface{}{"eslint*"},
+					"exclude_patterns": []interface{}{},
+					"update_types":     []interface{}{},
+				}},
+				"commit_message": []interface{}{map[string]interface{}{"prefix": "deps", "prefix_development": "", "include": "scope"}},
+			}),
+		}, []interface{}{registry})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		expected := `version: 2
+registries:
+  npm-github:
+    type: npm-registry
+    url: https://npm.pkg.github.com
+    token: ${{secrets.NPM_TOKEN}}
+    replaces-base: true
+updates:
+  - package-ecosystem: gomod
+    directory: /
+    schedule:
+      interval: weekly
+      day: monday
+  - package-ecosystem: npm
+    directories:
+      - /web
+      - /docs
+    schedule:
+      interval: daily
+      time: "09:00"
+      timezone: Europe/Stockholm
+    open-pull-requests-limit: 0
+    registries:
+      - npm-github
+    groups:
+      eslint:
+        patterns:
+          - eslint*
+    commit-message:
+      prefix: deps
+      include: scope
+`
+		if content != expected {
+			t.Errorf("unexpected content:\n%s\nexpected:\n%s", content, expected)
+		}
+	})
+
+	invalid := []struct {
+		name       string
+		updates    []interface{}
+		registries []interface{}
+		err        string
+	}{
+		{
+			name:    "neither directory nor directories",
+			updates: []interface{}{update(map[string]interface{}{"directory": ""})},
+			err:     "exactly one of directory or directories",
+		},
+		{
+			name:    "both directory and directories",
+			updates: []interface{}{update(map[string]interface{}{"directories": []interface{}{"/web"}})},
+			err:     "exactly one of directory or directories",
+		},
+		{
+			name: "day without a weekly interval",
+			updates: []interface{}{update(map[string]interface{}{
+				"schedule": []interface{}{map[string]interface{}{"interval": "daily", "day": "monday", "time": "", "timezone": ""}},
+			})},
+			err: "weekly interval",
+		},
+		{
+			name:    "undeclared registry",
+			updates: []interface{}{update(map[string]interface{}{"registries": []interface{}{"npm-github"}})},
+			err:     `registry "npm-github", which is not declared`,
+		},
+		{
+			name:    "duplicate update",
+			updates: []interface{}{update(nil), update(nil)},
+			err:     "declared more than once",
+		},
+		{
+			name:       "duplicate registry",
+			updates:    []interface{}{update(nil)},
+			registries: []interface{}{registry, registry},
+			err:        `registry "npm-github" is declared more than once`,
+		},
+	}
+
+	for _, tc := range invalid {
+		t.Run(fmt.Sprintf("rejects %s", tc.name), func(t *testing.T) {
+			_, err := renderDependabotConfiguration(tc.updates, tc.registries)
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestAccGithubDependabotConfiguration(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("creates and updates a dependabot configuration", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_dependabot_configuration" "test" {
+				repository = github_repository.test.name
+
+				update {
+					package_ecosystem = "github-actions"
+					directory         = "/"
+
+					schedule {
+						interval = "%s"
+					}
+				}
+			}
+		`, randomID, "%s")
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestMatchResourceAttr(
+					"github_dependabot_configuration.test", "content",
+					regexp.MustCompile("interval: daily"),
+				),
+				resource.TestCheckResourceAttrSet(
+					"github_dependabot_configuration.test", "sha",
+				),
+				resource.TestCheckResourceAttrSet(
+					"github_dependabot_configuration.test", "commit_sha",
+				),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestMatchResourceAttr(
+					"github_dependabot_configuration.test", "content",
+					regexp.MustCompile("interval: weekly"),
+				),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, "daily"),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, "weekly"),
+						Check:  checks["after"],
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+
+	t.Run("rejects an invalid configuration at plan time", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_dependabot_configuration" "test" {
+				repository = github_repository.test.name
+
+				update {
+					package_ecosystem = "npm"
+					directory         = "/"
+					registries        = ["missing"]
+
+					schedule {
+						interval = "weekly"
+					}
+				}
+			}
+		`, randomID)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      config,
+						ExpectError: regexp.MustCompile(`which is not declared`),
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -0,0 +1,124 @@
+package github
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubDependabotOrganizationSecretRepository() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubDependabotOrganizationSecretRepositoryCreate,
+		Read:   resourceGithubDependabotOrganizationSecretRepositoryRead,
+		Delete: resourceGithubDependabotOrganizationSecretRepositoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"secret_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Name of the existing secret.",
+				ValidateDiagFunc: validateSecretNameFunc,
+			},
+			"repository_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the repository that can access the organization secret.",
+			},
+		},
+	}
+}
+
+func resourceGithubDependabotOrganizationSecretRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	secretName := d.Get("secret_name").(string)
+	repositoryID := int64(d.Get("repository_id").(int))
+
+	_, err = client.Dependabot.AddSelectedRepoToOrgSecret(ctx, owner, secretName, &github.Repository{ID: github.Int64(repositoryID)})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(secretName, strconv.FormatInt(repositoryID, 10)))
+	return resourceGithubDependabotOrganizationSecretRepositoryRead(d, meta)
+}
+
+func resourceGithubDependabotOrganizationSecretRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	secretName, repositoryIDString, err := parseTwoPartID(d.Id(), "secret_name", "repository_id")
+	if err != nil {
+		return err
+	}
+	repositoryID, err := strconv.ParseInt(repositoryIDString, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(repositoryIDString, err)
+	}
+
+	opt := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+	for {
+		results, resp, err := client.Dependabot.ListSelectedReposForOrgSecret(ctx, owner, secretName, opt)
+		if err != nil {
+			return err
+		}
+
+		for _, repo := range results.Repositories {
+			if repo.GetID() == repositoryID {
+				if err = d.Set("secret_name", secretName); err != nil {
+					return err
+				}
+				return d.Set("repository_id", repositoryID)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	log.Printf("[INFO] Removing repository %d from state because it no longer has access to Dependabot secret %s", repositoryID, secretName)
+	d.SetId("")
+	return nil
+}
+
+func resourceGithubDependabotOrganizationSecretRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	secretName := d.Get("secret_name").(string)
+	repositoryID := int64(d.Get("repository_id").(int))
+
+	_, err = client.Dependabot.RemoveSelectedRepoFromOrgSecret(ctx, owner, secretName, &github.Repository{ID: github.Int64(repositoryID)})
+	return err
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/shurcooL/githubv4"
 )
 
@@ -66,6 +67,19 @@ func resourceGithubEnterpriseOrganization() *schema.Resource {
 				Required:    true,
 				Description: "The billing email address.",
 			},
+			"default_repository_permission": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The default permission of organization members on the repositories of the organization. Can be one of 'read', 'write', 'admin' or 'none'.",
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"read", "write", "admin", "none"}, false), "default_repository_permission"),
+			},
+			"members_can_create_repositories": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether or not organization members can create new repositories.",
+			},
 		},
 	}
 }
@@ -88,10 +102,15 @@ func resourceGithubEnterpriseOrganizationCreate(data *schema.ResourceData, meta
 		adminLogins = append(adminLogins, githubv4.String(v.(string)))
 	}
 
+	profileName := data.Get("name").(string)
+	if displayName := data.Get("display_name").(string); displayName != "" {
+		profileName = displayName
+	}
+
 	input := githubv4.CreateEnterpriseOrganizationInput{
 		EnterpriseID: data.Get("enterprise_id"),
 		Login:        githubv4.String(data.Get("name").(string)),
-		ProfileName:  githubv4.String(data.Get("name").(string)),
+		ProfileName:  githubv4.String(profileName),
 		BillingEmail: githubv4.String(data.Get("billing_email").(string)),
 		AdminLogins:  adminLogins,
 	}
@@ -102,8 +121,8 @@ func resourceGithubEnterpriseOrganizationCreate(data *schema.ResourceData, meta
 	}
 	data.SetId(fmt.Sprintf("%s", mutate.CreateEnterpriseOrganization.Organization.ID))
 
-	//We use the V3 api to set the description of the org, because there is no mutator in the V4 API to edit the org's
-	//description and display name
+	//We use the V3 api to set the description and member settings of the org, because there is no mutator in the V4
+	//API to edit them
 
 	//NOTE: There is some odd behavior here when using an EMU with SSO. If the user token has been granted permission to
 	//ANY ORG in the enterprise, then this works, provided that our token has sufficient permission. If the user token
@@ -116,21 +135,39 @@ func resourceGithubEnterpriseOrganizationCreate(data *schema.ResourceData, meta
 	//
 	//It would be nice if there was an API available in github to enable a token for SSO.
 
-	description := data.Get("description").(string)
-	displayName := data.Get("display_name").(string)
-	if description != "" || displayName != "" {
-		_, _, err = v3.Organizations.Edit(
-			context.Background(),
-			data.Get("name").(string),
-			&github.Organization{
-				Description: github.String(description),
-				Name:        github.String(displayName),
-			},
-		)
+	//The description and member settings are all set with a single call, and only when at least one of them is
+	//configured.
+	settings := &github.Organization{}
+	if description := data.Get("description").(string); description != "" {
+		settings.Description = github.String(description)
+	}
+	if permission, ok := data.GetOk("default_repository_permission"); ok {
+		settings.DefaultRepoPermission = github.String(permission.(string))
+	}
+	if v := data.GetRawConfig().GetAttr("members_can_create_repositories"); !v.IsNull() {
+		settings.MembersCanCreateRepos = github.Bool(v.True())
+	}
+
+	if *settings == (github.Organization{}) {
+		return nil
+	}
+
+	org, _, err := v3.Organizations.Edit(context.Background(), data.Get("name").(string), settings)
+	if err != nil {
 		return err
 	}
-	return nil
+	return setEnterpriseOrganizationMemberSettings(data, org)
+}
 
+// setEnterpriseOrganizationMemberSettings stores the member settings of the
+// organization. They are only available through the v3 API, so they are
+// refreshed once they have been set by Terraform.
+func setEnterpriseOrganizationMemberSettings(data *schema.ResourceData, org *github.Organization) error {
+	err := data.Set("default_repository_permission", org.GetDefaultRepoPermission())
+	if err != nil {
+		return err
+	}
+	return data.Set("members_can_create_repositories", org.GetMembersCanCreateRepos())
 }
 
 func resourceGithubEnterpriseOrganizationRead(data *schema.ResourceData, meta interface{}) error {
@@ -216,7 +253,18 @@ func resourceGithubEnterpriseOrganizationRead(data *schema.ResourceData, meta in
 	}
 
 	err = data.Set("description", query.Node.Organization.Description)
-	return err
+	if err != nil {
+		return err
+	}
+
+	if _, ok := data.GetOk("default_repository_permission"); ok {
+		org, _, err := meta.(*Owner).v3client.Organizations.Get(context.Background(), string(query.Node.Organization.Login))
+		if err != nil {
+			return err
+		}
+		return setEnterpriseOrganizationMemberSettings(data, org)
+	}
+	return nil
 }
 
 func resourceGithubEnterpriseOrganizationDelete(data *schema.ResourceData, meta interface{}) error {
@@ -436,6 +484,26 @@ func updateBillingEmail(ctx context.Context, data *schema.ResourceData, orgName
 	return nil
 }
 
+func updateMemberSettings(ctx context.Context, data *schema.ResourceData, orgName string, v3 *github.Client) error {
+	if !data.HasChanges("default_repository_permission", "members_can_create_repositories") {
+		return nil
+	}
+
+	settings := &github.Organization{}
+	if permission, ok := data.GetOk("default_repository_permission"); ok {
+		settings.DefaultRepoPermission = github.String(permission.(string))
+	}
+	if v := data.GetRawConfig().GetAttr("members_can_create_repositories"); !v.IsNull() {
+		settings.MembersCanCreateRepos = github.Bool(v.True())
+	}
+
+	org, _, err := v3.Organizations.Edit(ctx, orgName, settings)
+	if err != nil {
+		return err
+	}
+	return setEnterpriseOrganizationMemberSettings(data, org)
+}
+
 func resourceGithubEnterpriseOrganizationUpdate(data *schema.ResourceData, meta interface{}) error {
 	v3 := meta.(*Owner).v3client
 	v4 := meta.(*Owner).v4client
@@ -457,7 +525,12 @@ func resourceGithubEnterpriseOrganizationUpdate(data *schema.ResourceData, meta
 		return err
 	}
 
-	return updateBillingEmail(ctx, data, orgName, v3)
+	err = updateBillingEmail(ctx, data, orgName, v3)
+	if err != nil {
+		return err
+	}
+
+	return updateMemberSettings(ctx, data, orgName, v3)
 }
 
 func getUserIds(v4 *githubv4.Client, loginNames []interface{}) ([]githubv4.ID, error) {
@@ -0,0 +1,428 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubEnterpriseOrganizationAdmin() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubEnterpriseOrganizationAdminCreate,
+		Read:   resourceGithubEnterpriseOrganizationAdminRead,
+		Update: resourceGithubEnterpriseOrganizationAdminUpdate,
+		Delete: resourceGithubEnterpriseOrganizationAdminDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGithubEnterpriseOrganizationAdminImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"enterprise_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The slug of the enterprise.",
+			},
+			"username": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: caseInsensitive(),
+				Description:      "The user to manage administrative roles for.",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"role", "organizations"},
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+					string(githubv4.EnterpriseAdministratorRoleOwner),
+					string(githubv4.EnterpriseAdministratorRoleBillingManager),
+				}, false), "role"),
+				Description: "The enterprise administrator role of the user. Can be 'OWNER' or 'BILLING_MANAGER'.",
+			},
+			"organizations": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"role", "organizations"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "Logins of organizations in the enterprise the user should be an owner of.",
+			},
+			"invitation_pending": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the enterprise administrator invitation has not been accepted yet.",
+			},
+		},
+	}
+}
+
+type enterpriseAdministrator struct {
+	Role           string
+	InvitationID   string
+	PendingInvitee bool
+}
+
+func resourceGithubEnterpriseOrganizationAdminCreate(d *schema.ResourceData, meta interface{}) error {
+	v4 := meta.(*Owner).v4client
+	ctx := context.Background()
+
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+	username := d.Get("username").(string)
+
+	enterpriseID, err := getEnterpriseId(ctx, v4, enterpriseSlug)
+	if err != nil {
+		return err
+	}
+
+	if role, ok := d.GetOk("role"); ok {
+		err = inviteEnterpriseAdministrator(ctx, v4, enterpriseID, username, role.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(buildTwoPartID(enterpriseSlug, username))
+
+	err = addEnterpriseOrganizationAdmins(ctx, meta, enterpriseID, username, d.Get("organizations").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubEnterpriseOrganizationAdminRead(d, meta)
+}
+
+func resourceGithubEnterpriseOrganizationAdminRead(d *schema.ResourceData, meta interface{}) error {
+	v3 := meta.(*Owner).v3client
+	v4 := meta.(*Owner).v4client
+
+	enterpriseSlug, username, err := parseTwoPartID(d.Id(), "enterprise_slug", "username")
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	admin, err := getEnterpriseAdministrator(ctx, v4, enterpriseSlug, username)
+	if err != nil {
+		return err
+	}
+
+	var organizations []interface{}
+	for _, org := range d.Get("organizations").(*schema.Set).List() {
+		membership, _, err := v3.Organizations.GetOrgMembership(ctx, username, org.(string))
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return err
+		}
+		if membership.GetState() == "active" && membership.GetRole() == "admin" {
+			organizations = append(organizations, org)
+		}
+	}
+
+	if admin == nil && len(organizations) == 0 {
+		log.Printf("[INFO] Removing enterprise organization admin %s from state because it no longer exists in GitHub", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("enterprise_slug", enterpriseSlug); err != nil {
+		return err
+	}
+	if err = d.Set("username", username); err != nil {
+		return err
+	}
+
+	role := ""
+	pending := false
+	if admin != nil {
+		role = admin.Role
+		pending = admin.PendingInvitee
+	}
+	if err = d.Set("role", role); err != nil {
+		return err
+	}
+	if err = d.Set("invitation_pending", pending); err != nil {
+		return err
+	}
+
+	return d.Set("organizations", schema.NewSet(schema.HashString, organizations))
+}
+
+func resourceGithubEnterpriseOrganizationAdminUpdate(d *schema.ResourceData, meta interface{}) error {
+	v3 := meta.(*Owner).v3client
+	v4 := meta.(*Owner).v4client
+
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+	username := d.Get("username").(string)
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	enterpriseID, err := getEnterpriseId(ctx, v4, enterpriseSlug)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("role") {
+		admin, err := getEnterpriseAdministrator(ctx, v4, enterpriseSlug, username)
+		if err != nil {
+			return err
+		}
+
+		role := d.Get("role").(string)
+		switch {
+		case admin == nil && role != "":
+			err = inviteEnterpriseAdministrator(ctx, v4, enterpriseID, username, role)
+		case admin != nil && role == "":
+			err = removeEnterpriseAdministrator(ctx, v4, enterpriseID, username, admin)
+		case admin != nil && admin.PendingInvitee:
+			// The role of a pending invitation cannot be changed, so the
+			// invitation is replaced instead.
+			err = removeEnterpriseAdministrator(ctx, v4, enterpriseID, username, admin)
+			if err == nil {
+				err = inviteEnterpriseAdministrator(ctx, v4, enterpriseID, username, role)
+			}
+		case admin != nil:
+			err = updateEnterpriseAdministratorRole(ctx, v4, enterpriseID, username, role)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("organizations") {
+		oldSet, newSet := setChanges(d.GetChange("organizations"))
+
+		err = addEnterpriseOrganizationAdmins(ctx, meta, enterpriseID, username, newSet.Difference(oldSet).List())
+		if err != nil {
+			return err
+		}
+
+		for _, org := range oldSet.Difference(newSet).List() {
+			err = demoteOrganizationAdmin(ctx, v3, username, org.(string))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceGithubEnterpriseOrganizationAdminRead(d, meta)
+}
+
+func resourceGithubEnterpriseOrganizationAdminDelete(d *schema.ResourceData, meta interface{}) error {
+	v3 := meta.(*Owner).v3client
+	v4 := meta.(*Owner).v4client
+
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+	username := d.Get("username").(string)
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	for _, org := range d.Get("organizations").(*schema.Set).List() {
+		err := demoteOrganizationAdmin(ctx, v3, username, org.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	admin, err := getEnterpriseAdministrator(ctx, v4, enterpriseSlug, username)
+	if err != nil {
+		return err
+	}
+	if admin == nil {
+		return nil
+	}
+
+	enterpriseID, err := getEnterpriseId(ctx, v4, enterpriseSlug)
+	if err != nil {
+		return err
+	}
+
+	return removeEnterpriseAdministrator(ctx, v4, enterpriseID, username, admin)
+}
+
+func resourceGithubEnterpriseOrganizationAdminImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	enterpriseSlug, username, err := parseTwoPartID(d.Id(), "enterprise_slug", "username")
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(buildTwoPartID(enterpriseSlug, username))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getEnterpriseAdministrator returns the enterprise administrator role of the
+// user, including pending invitations, or nil if the user holds no such role.
+func getEnterpriseAdministrator(ctx context.Context, v4 *githubv4.Client, enterpriseSlug string, username string) (*enterpriseAdministrator, error) {
+	var query struct {
+		Enterprise struct {
+			OwnerInfo struct {
+				Admins struct {
+					Edges []struct {
+						Node struct {
+							Login githubv4.String
+						}
+						Role githubv4.EnterpriseAdministratorRole
+					}
+				} `graphql:"admins(first:100, query:$login)"`
+				PendingAdminInvitations struct {
+					Nodes []struct {
+						ID      githubv4.ID
+						Role    githubv4.EnterpriseAdministratorRole
+						Invitee struct {
+							Login githubv4.String
+						}
+					}
+				} `graphql:"pendingAdminInvitations(first:100, query:$login)"`
+			}
+		} `graphql:"enterprise(slug: $slug)"`
+	}
+
+	variables := map[string]interface{}{
+		"slug":  githubv4.String(enterpriseSlug),
+		"login": githubv4.String(username),
+	}
+
+	err := v4.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, edge := range query.Enterprise.OwnerInfo.Admins.Edges {
+		if strings.EqualFold(string(edge.Node.Login), username) {
+			return &enterpriseAdministrator{Role: string(edge.Role)}, nil
+		}
+	}
+
+	for _, invitation := range query.Enterprise.OwnerInfo.PendingAdminInvitations.Nodes {
+		if strings.EqualFold(string(invitation.Invitee.Login), username) {
+			return &enterpriseAdministrator{
+				Role:           string(invitation.Role),
+				InvitationID:   invitation.ID.(string),
+				PendingInvitee: true,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func inviteEnterpriseAdministrator(ctx context.Context, v4 *githubv4.Client, enterpriseID string, username string, role string) error {
+	var mutate struct {
+		InviteEnterpriseAdmin struct {
+			Ignored string `graphql:"clientMutationId"`
+		} `graphql:"inviteEnterpriseAdmin(input: $input)"`
+	}
+
+	adminRole := githubv4.EnterpriseAdministratorRole(role)
+	input := githubv4.InviteEnterpriseAdminInput{
+		EnterpriseID: githubv4.ID(enterpriseID),
+		Invitee:      githubv4.NewString(githubv4.String(username)),
+		Role:         &adminRole,
+	}
+
+	return v4.Mutate(ctx, &mutate, input, nil)
+}
+
+func updateEnterpriseAdministratorRole(ctx context.Context, v4 *githubv4.Client, enterpriseID string, username string, role string) error {
+	var mutate struct {
+		UpdateEnterpriseAdministratorRole struct {
+			Ignored string `graphql:"clientMutationId"`
+		} `graphql:"updateEnterpriseAdministratorRole(input: $input)"`
+	}
+
+	input := githubv4.UpdateEnterpriseAdministratorRoleInput{
+		EnterpriseID: githubv4.ID(enterpriseID),
+		Login:        githubv4.String(username),
+		Role:         githubv4.EnterpriseAdministratorRole(role),
+	}
+
+	return v4.Mutate(ctx, &mutate, input, nil)
+}
+
+func removeEnterpriseAdministrator(ctx context.Context, v4 *githubv4.Client, enterpriseID string, username string, admin *enterpriseAdministrator) error {
+	if admin.PendingInvitee {
+		var mutate struct {
+			CancelEnterpriseAdminInvitation struct {
+				Ignored string `graphql:"clientMutationId"`
+			} `graphql:"cancelEnterpriseAdminInvitation(input: $input)"`
+		}
+
+		input := githubv4.CancelEnterpriseAdminInvitationInput{
+			InvitationID: githubv4.ID(admin.InvitationID),
+		}
+
+		return v4.Mutate(ctx, &mutate, input, nil)
+	}
+
+	var mutate struct {
+		RemoveEnterpriseAdmin struct {
+			Ignored string `graphql:"clientMutationId"`
+		} `graphql:"removeEnterpriseAdmin(input: $input)"`
+	}
+
+	input := githubv4.RemoveEnterpriseAdminInput{
+		EnterpriseID: githubv4.ID(enterpriseID),
+		Login:        githubv4.String(username),
+	}
+
+	return v4.Mutate(ctx, &mutate, input, nil)
+}
+
+func addEnterpriseOrganizationAdmins(ctx context.Context, meta interface{}, enterpriseID string, username string, organizations []interface{}) error {
+	if len(organizations) == 0 {
+		return nil
+	}
+
+	v4 := meta.(*Owner).v4client
+
+	userIds, err := getUserIds(v4, []interface{}{username})
+	if err != nil {
+		return err
+	}
+
+	adminRole := githubv4.OrganizationMemberRoleAdmin
+	for _, org := range organizations {
+		orgID, err := getOrganizationId(ctx, v4, org.(string))
+		if err != nil {
+			return err
+		}
+
+		var mutate struct {
+			AddEnterpriseOrganizationMember struct {
+				Ignored string `graphql:"clientMutationId"`
+			} `graphql:"addEnterpriseOrganizationMember(input: $input)"`
+		}
+
+		input := githubv4.AddEnterpriseOrganizationMemberInput{
+			EnterpriseID:   githubv4.ID(enterpriseID),
+			OrganizationID: githubv4.ID(orgID),
+			UserIDs:        userIds,
+			Role:           &adminRole,
+		}
+
+		err = v4.Mutate(ctx, &mutate, input, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// demoteOrganizationAdmin makes the user a regular member of the organization
+// rather than removing them from it, as their membership may predate being
+// made an owner by this resource.
+func demoteOrganizationAdmin(ctx context.Context, v3 *github.Client, username string, org string) error {
+	_, _, err := v3.Organizations.EditOrgMembership(ctx, username, org, &github.Membership{Role: github.String("member")})
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Not demoting %s in organization %s as they are no longer a member", username, org)
+			return nil
+		}
+	}
+	return err
+}
@@ -0,0 +1,136 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubEnterpriseOrganizationAdmin(t *testing.T) {
+
+	inOrgUser := os.Getenv("GITHUB_IN_ORG_USER")
+
+	t.Run("assigns an organization owner in an enterprise organization without error", func(t *testing.T) {
+		if inOrgUser == "" {
+			t.Skip("Skipping because `GITHUB_IN_ORG_USER` is not set")
+		}
+
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		orgName := fmt.Sprintf("tf-acc-test-%s", randomID)
+
+		config := fmt.Sprintf(`
+		  data "github_enterprise" "enterprise" {
+			slug = "%s"
+		  }
+
+		  data "github_user" "current" {
+			username = ""
+		  }
+
+		  resource "github_enterprise_organization" "org" {
+			enterprise_id = data.github_enterprise.enterprise.id
+			name          = "%s"
+			billing_email = data.github_user.current.email
+			admin_logins  = [
+			  data.github_user.current.login
+			]
+
+			lifecycle {
+			  ignore_changes = [admin_logins]
+			}
+		  }
+
+		  resource "github_enterprise_organization_admin" "test" {
+			enterprise_slug = data.github_enterprise.enterprise.slug
+			username        = "%s"
+			organizations   = [github_enterprise_organization.org.name]
+		  }
+		`, testEnterprise, orgName, inOrgUser)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(
+				"github_enterprise_organization_admin.test", "organizations.#",
+				"1",
+			),
+			resource.TestCheckResourceAttr(
+				"github_enterprise_organization_admin.test", "role",
+				"",
+			),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an enterprise account", func(t *testing.T) {
+			if isEnterprise != "true" {
+				t.Skip("Skipping because `ENTERPRISE_ACCOUNT` is not set or set to false")
+			}
+			if testEnterprise == "" {
+				t.Skip("Skipping because `ENTERPRISE_SLUG` is not set")
+			}
+			testCase(t, enterprise)
+		})
+	})
+}
+
+func TestDemoteOrganizationAdmin(t *testing.T) {
+	newClient := func(responses []*mockResponse) (*github.Client, func()) {
+		ts := githubApiMock(responses)
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+		return client, ts.Close
+	}
+
+	t.Run("makes the user a member", func(t *testing.T) {
+		client, done := newClient([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/memberships/octocat",
+				ExpectedMethod: "PUT",
+				ExpectedBody:   []byte(`{"role":"member"}` + "\n"),
+				ResponseBody:   `{"state": "active", "role": "member"}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer done()
+
+		err := demoteOrganizationAdmin(context.Background(), client, "octocat", "example")
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+
+	t.Run("ignores users who are no longer members", func(t *testing.T) {
+		client, done := newClient([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/memberships/octocat",
+				ExpectedMethod: "PUT",
+				ResponseBody:   `{"message": "Not Found"}`,
+				StatusCode:     http.StatusNotFound,
+			},
+		})
+		defer done()
+
+		err := demoteOrganizationAdmin(context.Background(), client, "octocat", "example")
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+}
@@ -407,6 +407,89 @@ func TestAccGithubEnterpriseOrganization(t *testing.T) {
 
 	})
 
+	t.Run("creates and updates org member settings", func(t *testing.T) {
+
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		orgName := fmt.Sprintf("tf-acc-test-membersettings%s", randomID)
+
+		config := fmt.Sprintf(`
+		  data "github_enterprise" "enterprise" {
+			slug = "%s"
+		  }
+
+		  data "github_user" "current" {
+			username = ""
+		  }
+
+		  resource "github_enterprise_organization" "org" {
+			enterprise_id                   = data.github_enterprise.enterprise.id
+			name                            = "%s"
+			billing_email                   = data.github_user.current.email
+			default_repository_permission   = "write"
+			members_can_create_repositories = false
+			admin_logins                    = [
+			  data.github_user.current.login
+			]
+		  }
+			`, testEnterprise, orgName)
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(
+					"github_enterprise_organization.org", "default_repository_permission",
+					"write",
+				),
+				resource.TestCheckResourceAttr(
+					"github_enterprise_organization.org", "members_can_create_repositories",
+					"false",
+				),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(
+					"github_enterprise_organization.org", "default_repository_permission",
+					"read",
+				),
+				resource.TestCheckResourceAttr(
+					"github_enterprise_organization.org", "members_can_create_repositories",
+					"true",
+				),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  checks["before"],
+					},
+					{
+						Config: strings.Replace(
+							strings.Replace(config,
+								`"write"`,
+								`"read"`, 1),
+							"= false",
+							"= true", 1),
+						Check: checks["after"],
+					},
+				},
+			})
+		}
+
+		t.Run("with an enterprise account", func(t *testing.T) {
+			if isEnterprise != "true" {
+				t.Skip("Skipping because `ENTERPRISE_ACCOUNT` is not set or set to false")
+			}
+			if testEnterprise == "" {
+				t.Skip("Skipping because `ENTERPRISE_SLUG` is not set")
+			}
+			testCase(t, enterprise)
+		})
+
+	})
+
 	t.Run("imports enterprise organization without error", func(t *testing.T) {
 		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
 		orgName := fmt.Sprintf("tf-acc-test-import%s", randomID)
@@ -0,0 +1,235 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubEventualConsistencyBarrier() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubEventualConsistencyBarrierCreate,
+		Read:   resourceGithubEventualConsistencyBarrierRead,
+		Delete: resourceGithubEventualConsistencyBarrierDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"repository", "team", "path"},
+				Description:  "The name of a repository to wait for until it can be read.",
+			},
+			"branch": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"repository"},
+				Description:  "The name of a branch of the repository to wait for until it can be read.",
+			},
+			"team": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"repository", "team", "path"},
+				Description:  "The slug of a team to wait for until it can be read.",
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"team"},
+				Description:  "The username of a member to wait for until their membership of the team is active.",
+			},
+			"path": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				AtLeastOneOf:     []string{"repository", "team", "path"},
+				ValidateDiagFunc: toDiagFunc(validateEventualConsistencyBarrierPath, "path"),
+				Description: "A path of the REST API, relative to the API base URL, to read until it responds with " +
+					"'expected_status', e.g. 'repos/octo-org/octo-repo/collaborators/octocat'.",
+			},
+			"expected_status": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          http.StatusOK,
+				ValidateDiagFunc: toDiagFunc(validation.IntBetween(200, 499), "expected_status"),
+				Description:      "The status code the 'path' must respond with for the barrier to converge.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that, when changed, wait for the barrier again.",
+			},
+		},
+	}
+}
+
+func resourceGithubEventualConsistencyBarrierCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx := context.Background()
+
+	barrier := eventualConsistencyBarrier{
+		repository: d.Get("repository").(string),
+		branch:     d.Get("branch").(string),
+		team:       d.Get("team").(string),
+		username:   d.Get("username").(string),
+		path:       d.Get("path").(string),
+	}
+	if barrier.path != "" {
+		barrier.expectedStatus = d.Get("expected_status").(int)
+	}
+
+	if barrier.team != "" {
+		err := checkOrganization(meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		err := barrier.check(ctx, meta)
+		if err == nil {
+			return nil
+		}
+		if _, ok := err.(*eventualConsistencyPendingError); ok {
+			log.Printf("[DEBUG] Waiting for eventual consistency: %s", err)
+			return retry.RetryableError(err)
+		}
+		return retry.NonRetryableError(err)
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.UniqueId())
+	return resourceGithubEventualConsistencyBarrierRead(d, meta)
+}
+
+func resourceGithubEventualConsistencyBarrierRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceGithubEventualConsistencyBarrierDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+// eventualConsistencyBarrier describes the objects that must be visible
+// through the API before the barrier converges.
+type eventualConsistencyBarrier struct {
+	repository     string
+	branch         string
+	team           string
+	username       string
+	path           string
+	expectedStatus int
+}
+
+// validateEventualConsistencyBarrierPath only allows paths relative to the
+// API base URL, so the requests can't be sent to another host.
+func validateEventualConsistencyBarrierPath(v interface{}, k string) ([]string, []error) {
+	path := v.(string)
+	if strings.Contains(path, "://") || strings.HasPrefix(path, "//") {
+		return nil, []error{fmt.Errorf("%s must be a path relative to the API base URL, got %q", k, path)}
+	}
+	return nil, nil
+}
+
+// eventualConsistencyPendingError reports an object that is not visible yet.
+type eventualConsistencyPendingError struct {
+	message string
+}
+
+func (e *eventualConsistencyPendingError) Error() string {
+	return e.message
+}
+
+// check returns an *eventualConsistencyPendingError while any of the objects
+// of the barrier can't be read yet, and any other error as is.
+func (b eventualConsistencyBarrier) check(ctx context.Context, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	if b.repository != "" {
+		_, _, err := client.Repositories.Get(ctx, owner, b.repository)
+		if err != nil {
+			return eventualConsistencyError(err, "repository %s/%s is not visible yet", owner, b.repository)
+		}
+	}
+
+	if b.branch != "" {
+		_, _, err := client.Repositories.GetBranch(ctx, owner, b.repository, b.branch, 1)
+		if err != nil {
+			return eventualConsistencyError(err, "branch %s of repository %s/%s is not visible yet", b.branch, owner, b.repository)
+		}
+	}
+
+	if b.team != "" {
+		_, _, err := client.Teams.GetTeamBySlug(ctx, owner, b.team)
+		if err != nil {
+			return eventualConsistencyError(err, "team %s/%s is not visible yet", owner, b.team)
+		}
+	}
+
+	if b.username != "" {
+		membership, _, err := client.Teams.GetTeamMembershipBySlug(ctx, owner, b.team, b.username)
+		if err != nil {
+			return eventualConsistencyError(err, "membership of %s in team %s/%s is not visible yet", b.username, owner, b.team)
+		}
+		if membership.GetState() != "active" {
+			return &eventualConsistencyPendingError{
+				message: fmt.Sprintf("membership of %s in team %s/%s is %s", b.username, owner, b.team, membership.GetState()),
+			}
+		}
+	}
+
+	if b.path != "" {
+		req, err := client.NewRequest("GET", strings.TrimPrefix(b.path, "/"), nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(ctx, req, nil)
+		if resp == nil {
+			return err
+		}
+		if resp.StatusCode != b.expectedStatus {
+			// The object is either missing or not in the expected state
+			// yet, anything else is an error.
+			if resp.StatusCode == http.StatusNotFound || resp.StatusCode < 300 {
+				return &eventualConsistencyPendingError{
+					message: fmt.Sprintf("GET %s responded with %d instead of %d", b.path, resp.StatusCode, b.expectedStatus),
+				}
+			}
+			if err == nil {
+				err = fmt.Errorf("GET %s responded with %d instead of %d", b.path, resp.StatusCode, b.expectedStatus)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// eventualConsistencyError treats a not found response as pending.
+func eventualConsistencyError(err error, format string, args ...interface{}) error {
+	if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+		return &eventualConsistencyPendingError{message: fmt.Sprintf(format, args...)}
+	}
+	return err
+}
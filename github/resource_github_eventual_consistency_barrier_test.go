@@ -0,0 +1,207 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestEventualConsistencyBarrierCheck(t *testing.T) {
+	newMeta := func(responses []*mockResponse) (*Owner, func()) {
+		ts := githubApiMock(responses)
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+		return &Owner{name: "example", v3client: client, IsOrganization: true}, ts.Close
+	}
+
+	t.Run("reports a missing repository as pending", func(t *testing.T) {
+		meta, done := newMeta([]*mockResponse{
+			{
+				ExpectedUri:  "/repos/example/repo",
+				ResponseBody: `{"message": "Not Found"}`,
+				StatusCode:   http.StatusNotFound,
+			},
+		})
+		defer done()
+
+		err := eventualConsistencyBarrier{repository: "repo"}.check(context.Background(), meta)
+		if _, ok := err.(*eventualConsistencyPendingError); !ok {
+			t.Fatalf("Expected a pending error, got %v", err)
+		}
+	})
+
+	t.Run("reports a pending team membership as pending", func(t *testing.T) {
+		meta, done := newMeta([]*mockResponse{
+			{
+				ExpectedUri:  "/orgs/example/teams/team",
+				ResponseBody: `{"id": 1, "slug": "team"}`,
+				StatusCode:   http.StatusOK,
+			},
+			{
+				ExpectedUri:  "/orgs/example/teams/team/memberships/octocat",
+				ResponseBody: `{"state": "pending", "role": "member"}`,
+				StatusCode:   http.StatusOK,
+			},
+		})
+		defer done()
+
+		err := eventualConsistencyBarrier{team: "team", username: "octocat"}.check(context.Background(), meta)
+		if _, ok := err.(*eventualConsistencyPendingError); !ok {
+			t.Fatalf("Expected a pending error, got %v", err)
+		}
+	})
+
+	t.Run("converges once every object is visible", func(t *testing.T) {
+		meta, done := newMeta([]*mockResponse{
+			{
+				ExpectedUri:  "/repos/example/repo",
+				ResponseBody: `{"id": 1, "name": "repo"}`,
+				StatusCode:   http.StatusOK,
+			},
+			{
+				ExpectedUri:  "/repos/example/repo/branches/main",
+				ResponseBody: `{"name": "main"}`,
+				StatusCode:   http.StatusOK,
+			},
+		})
+		defer done()
+
+		err := eventualConsistencyBarrier{repository: "repo", branch: "main"}.check(context.Background(), meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+
+	t.Run("reads a path until it responds with the expected status", func(t *testing.T) {
+		meta, done := newMeta([]*mockResponse{
+			{
+				ExpectedUri:  "/repos/example/repo/collaborators/octocat",
+				ResponseBody: `{"message": "Not Found"}`,
+				StatusCode:   http.StatusNotFound,
+			},
+			{
+				ExpectedUri: "/repos/example/repo/collaborators/octocat",
+				StatusCode:  http.StatusNoContent,
+			},
+		})
+		defer done()
+
+		barrier := eventualConsistencyBarrier{path: "/repos/example/repo/collaborators/octocat", expectedStatus: http.StatusNoContent}
+		err := barrier.check(context.Background(), meta)
+		if _, ok := err.(*eventualConsistencyPendingError); !ok {
+			t.Fatalf("Expected a pending error, got %v", err)
+		}
+		err = barrier.check(context.Background(), meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+
+	t.Run("waits for a path to be removed", func(t *testing.T) {
+		meta, done := newMeta([]*mockResponse{
+			{
+				ExpectedUri:  "/orgs/example/teams/team",
+				ResponseBody: `{"message": "Not Found"}`,
+				StatusCode:   http.StatusNotFound,
+			},
+		})
+		defer done()
+
+		err := eventualConsistencyBarrier{path: "orgs/example/teams/team", expectedStatus: http.StatusNotFound}.check(context.Background(), meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+
+	t.Run("returns unexpected statuses of a path as errors", func(t *testing.T) {
+		meta, done := newMeta([]*mockResponse{
+			{
+				ExpectedUri:  "/orgs/example/teams/team",
+				ResponseBody: `{"message": "Forbidden"}`,
+				StatusCode:   http.StatusForbidden,
+			},
+		})
+		defer done()
+
+		err := eventualConsistencyBarrier{path: "orgs/example/teams/team", expectedStatus: http.StatusOK}.check(context.Background(), meta)
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+		if _, ok := err.(*eventualConsistencyPendingError); ok {
+			t.Fatalf("Expected a non pending error, got %s", err)
+		}
+	})
+
+	t.Run("returns other errors as is", func(t *testing.T) {
+		meta, done := newMeta([]*mockResponse{
+			{
+				ExpectedUri:  "/repos/example/repo",
+				ResponseBody: `{"message": "Forbidden"}`,
+				StatusCode:   http.StatusForbidden,
+			},
+		})
+		defer done()
+
+		err := eventualConsistencyBarrier{repository: "repo"}.check(context.Background(), meta)
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+		if _, ok := err.(*eventualConsistencyPendingError); ok {
+			t.Fatalf("Expected a non pending error, got %s", err)
+		}
+	})
+}
+
+func TestAccGithubEventualConsistencyBarrier(t *testing.T) {
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("waits for a new repository and branch", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-barrier-%s"
+				auto_init = true
+			}
+
+			resource "github_eventual_consistency_barrier" "test" {
+				repository = github_repository.test.name
+				branch     = "main"
+			}
+		`, randomID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet("github_eventual_consistency_barrier.test", "id"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -149,8 +149,18 @@ func resourceGithubIssueLabelsCreateOrUpdate(d *schema.ResourceData, meta interf
 				Color:       github.String(n["color"].(string)),
 				Description: github.String(n["description"].(string)),
 			})
+			if isAlreadyExistsError(err) {
+				// The label may have been created by an earlier apply that
+				// failed part-way, so update it in place instead.
+				log.Printf("[DEBUG] GitHub issue label %s/%s/%s already exists, updating it", owner, repository, name)
+				label, _, err = client.Issues.EditLabel(ctx, owner, repository, n["name"].(string), &github.Label{
+					Name:        github.String(n["name"].(string)),
+					Color:       github.String(n["color"].(string)),
+					Description: github.String(n["description"].(string)),
+				})
+			}
 			if err != nil {
-				return err
+				return labelsPartialUpdateErr(err, d, meta)
 			}
 
 			labels = append(labels, map[string]interface{}{
@@ -169,7 +179,7 @@ func resourceGithubIssueLabelsCreateOrUpdate(d *schema.ResourceData, meta interf
 
 			_, err := client.Issues.DeleteLabel(ctx, owner, repository, o["name"].(string))
 			if err != nil {
-				return err
+				return labelsPartialUpdateErr(err, d, meta)
 			}
 		}
 	}
@@ -186,7 +196,7 @@ func resourceGithubIssueLabelsCreateOrUpdate(d *schema.ResourceData, meta interf
 					Description: github.String(n["description"].(string)),
 				})
 				if err != nil {
-					return err
+					return labelsPartialUpdateErr(err, d, meta)
 				}
 
 				labels = append(labels, map[string]interface{}{
@@ -211,6 +221,17 @@ func resourceGithubIssueLabelsCreateOrUpdate(d *schema.ResourceData, meta interf
 	return nil
 }
 
+// labelsPartialUpdateErr records the labels applied so far when an update of
+// an existing resource fails; a new resource is safely created again as
+// already existing labels are updated in place.
+func labelsPartialUpdateErr(err error, d *schema.ResourceData, meta interface{}) error {
+	if d.Id() == "" {
+		return err
+	}
+
+	return recordPartialUpdate(err, d, meta, resourceGithubIssueLabelsRead)
+}
+
 func resourceGithubIssueLabelsDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Owner).v3client
 
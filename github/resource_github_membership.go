@@ -2,8 +2,10 @@ package github
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
+	"strings"
 
 	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,9 +13,9 @@ import (
 
 func resourceGithubMembership() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGithubMembershipCreateOrUpdate,
+		Create: resourceGithubMembershipCreate,
 		Read:   resourceGithubMembershipRead,
-		Update: resourceGithubMembershipCreateOrUpdate,
+		Update: resourceGithubMembershipUpdate,
 		Delete: resourceGithubMembershipDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -22,11 +24,21 @@ func resourceGithubMembership() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"username": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
 				ForceNew:         true,
+				ExactlyOneOf:     []string{"username", "email"},
 				DiffSuppressFunc: caseInsensitive(),
 				Description:      "The user to add to the organization.",
 			},
+			"email": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ExactlyOneOf:     []string{"username", "email"},
+				DiffSuppressFunc: caseInsensitive(),
+				Description:      "The email address to invite to the organization, instead of a username.",
+			},
 			"role": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -38,6 +50,16 @@ func resourceGithubMembership() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pending": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the invitation to the organization is yet to be accepted.",
+			},
+			"invitation_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the pending invitation of an email address.",
+			},
 			"downgrade_on_destroy": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -48,7 +70,7 @@ func resourceGithubMembership() *schema.Resource {
 	}
 }
 
-func resourceGithubMembershipCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceGithubMembershipCreate(d *schema.ResourceData, meta interface{}) error {
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -60,8 +82,20 @@ func resourceGithubMembershipCreateOrUpdate(d *schema.ResourceData, meta interfa
 	username := d.Get("username").(string)
 	roleName := d.Get("role").(string)
 	ctx := context.Background()
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxId, d.Id())
+
+	if email, ok := d.GetOk("email"); ok {
+		log.Printf("[INFO] Inviting '%s' to '%s' as '%s'", email, orgName, roleName)
+		_, _, err = client.Organizations.CreateOrgInvitation(ctx, orgName, &github.CreateOrgInvitationOptions{
+			Email: github.String(email.(string)),
+			Role:  github.String(membershipInvitationRole(roleName)),
+		})
+		if err != nil {
+			return err
+		}
+
+		d.SetId(buildTwoPartID(orgName, email.(string)))
+
+		return resourceGithubMembershipRead(d, meta)
 	}
 
 	_, _, err = client.Organizations.EditOrgMembership(ctx,
@@ -80,6 +114,56 @@ func resourceGithubMembershipCreateOrUpdate(d *schema.ResourceData, meta interfa
 	return resourceGithubMembershipRead(d, meta)
 }
 
+func resourceGithubMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+
+	orgName := meta.(*Owner).name
+	username := d.Get("username").(string)
+	roleName := d.Get("role").(string)
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	if !d.HasChange("role") {
+		return resourceGithubMembershipRead(d, meta)
+	}
+
+	if username == "" {
+		return fmt.Errorf("the role of %s in %s can't be changed to %s while their invitation is pending; "+
+			"apply again once they accepted the invitation", d.Get("email").(string), orgName, roleName)
+	}
+
+	// Setting the membership of a user who isn't an active member (re-)sends
+	// them an invitation, so the role is only changed for active members.
+	membership, _, err := client.Organizations.GetOrgMembership(ctx, username, orgName)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("%s is no longer a member of %s; refresh and apply again to invite them", username, orgName)
+		}
+		return err
+	}
+
+	if membership.GetState() != "active" {
+		return fmt.Errorf("the role of %s in %s can't be changed to %s while their membership is %s, as that would send "+
+			"them another invitation; apply again once they accepted the invitation", username, orgName, roleName, membership.GetState())
+	}
+
+	if membership.GetRole() != roleName {
+		log.Printf("[INFO] Changing '%s' membership for '%s' to '%s'", orgName, username, roleName)
+		_, _, err = client.Organizations.EditOrgMembership(ctx, username, orgName, &github.Membership{
+			Role: github.String(roleName),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubMembershipRead(d, meta)
+}
+
 func resourceGithubMembershipRead(d *schema.ResourceData, meta interface{}) error {
 	err := checkOrganization(meta)
 	if err != nil {
@@ -93,6 +177,11 @@ func resourceGithubMembershipRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return err
 	}
+	// Usernames can't contain an @, so such IDs are invitations of an email
+	// address.
+	if strings.Contains(username, "@") {
+		return resourceGithubMembershipReadInvitation(d, meta, username)
+	}
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
@@ -124,10 +213,166 @@ func resourceGithubMembershipRead(d *schema.ResourceData, meta interface{}) erro
 	if err = d.Set("role", membership.GetRole()); err != nil {
 		return err
 	}
+	if err = d.Set("pending", membership.GetState() == "pending"); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// resourceGithubMembershipReadInvitation reads the membership of an invited
+// email address. The invitation is read while it is pending, and the
+// membership of the invitee afterwards, provided the invitation was sent to
+// the email address of a GitHub user.
+func resourceGithubMembershipReadInvitation(d *schema.ResourceData, meta interface{}, email string) error {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	if err := d.Set("email", email); err != nil {
+		return err
+	}
+
+	invitation, err := findOrgInvitationByEmail(ctx, client, orgName, email)
+	if err != nil {
+		return err
+	}
+	if invitation != nil {
+		if err = d.Set("username", invitation.GetLogin()); err != nil {
+			return err
+		}
+		if err = d.Set("role", membershipRole(invitation.GetRole())); err != nil {
+			return err
+		}
+		if err = d.Set("invitation_id", invitation.GetID()); err != nil {
+			return err
+		}
+		return d.Set("pending", true)
+	}
+
+	username := d.Get("username").(string)
+	if username == "" {
+		login, failed, err := resolveOrgInvitee(ctx, client, orgName, email, int64(d.Get("invitation_id").(int)))
+		if err != nil {
+			return err
+		}
+		if failed {
+			log.Printf("[INFO] Removing membership %s from state because the invitation expired or failed",
+				d.Id())
+			d.SetId("")
+			return nil
+		}
+		if login == "" {
+			// The invitation was accepted, but the email address isn't
+			// public, so the member is kept in state as is rather than
+			// being invited again.
+			log.Printf("[WARN] The invitation of %s to %s is no longer pending, but the member can't be found by "+
+				"their email address; import the membership by username to manage it", email, orgName)
+			return d.Set("pending", false)
+		}
+		username = login
+		if err = d.Set("username", username); err != nil {
+			return err
+		}
+	}
+
+	membership, _, err := client.Organizations.GetOrgMembership(ctx, username, orgName)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing membership %s from state because it no longer exists in GitHub",
+				d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if err = d.Set("role", membership.GetRole()); err != nil {
+		return err
+	}
+	if err = d.Set("invitation_id", 0); err != nil {
+		return err
+	}
+	return d.Set("pending", membership.GetState() == "pending")
+}
+
+// findOrgInvitationByEmail returns the pending invitation of the email
+// address to the organization, or nil if there is none.
+func findOrgInvitationByEmail(ctx context.Context, client *github.Client, orgName, email string) (*github.Invitation, error) {
+	options := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		invitations, resp, err := client.Organizations.ListPendingOrgInvitations(ctx, orgName, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, invitation := range invitations {
+			if strings.EqualFold(invitation.GetEmail(), email) {
+				return invitation, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+		options.Page = resp.NextPage
+	}
+}
+
+// resolveOrgInvitee returns the login of the user who accepted the invitation
+// of the email address to the organization, or whether the invitation failed
+// instead, e.g. because it expired. The login is empty if the invitation was
+// accepted but the user can't be found by their email address.
+func resolveOrgInvitee(ctx context.Context, client *github.Client, orgName, email string, invitationID int64) (string, bool, error) {
+	options := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		invitations, resp, err := client.Organizations.ListFailedOrgInvitations(ctx, orgName, options)
+		if err != nil {
+			return "", false, err
+		}
+
+		for _, invitation := range invitations {
+			// Earlier invitations of the same email address may have failed,
+			// so the email address is only matched without an invitation ID.
+			if invitationID != 0 && invitation.GetID() == invitationID ||
+				invitationID == 0 && strings.EqualFold(invitation.GetEmail(), email) {
+				return "", true, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	result, _, err := client.Search.Users(ctx, fmt.Sprintf("%s in:email", email), nil)
+	if err != nil {
+		return "", false, err
+	}
+	if len(result.Users) != 1 {
+		return "", false, nil
+	}
+	return result.Users[0].GetLogin(), false, nil
+}
+
+// membershipInvitationRole returns the role to invite someone with to get
+// the given membership role.
+func membershipInvitationRole(role string) string {
+	if role == "member" {
+		return "direct_member"
+	}
+	return role
+}
+
+// membershipRole is the inverse of membershipInvitationRole.
+func membershipRole(invitationRole string) string {
+	if invitationRole == "direct_member" {
+		return "member"
+	}
+	return invitationRole
+}
+
 func resourceGithubMembershipDelete(d *schema.ResourceData, meta interface{}) error {
 	err := checkOrganization(meta)
 	if err != nil {
@@ -142,6 +387,21 @@ func resourceGithubMembershipDelete(d *schema.ResourceData, meta interface{}) er
 	downgradeOnDestroy := d.Get("downgrade_on_destroy").(bool)
 	downgradeTo := "member"
 
+	if invitationID := d.Get("invitation_id").(int); invitationID != 0 && username == "" {
+		log.Printf("[INFO] Cancelling the invitation of '%s' to '%s'", d.Get("email").(string), orgName)
+		req, err := client.NewRequest("DELETE", fmt.Sprintf("orgs/%s/invitations/%d", orgName, invitationID), nil)
+		if err != nil {
+			return err
+		}
+		_, err = client.Do(ctx, req, nil)
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] The invitation of '%s' to '%s' is no longer pending; if it was accepted, "+
+				"the member has to be removed from the organization separately", d.Get("email").(string), orgName)
+			return nil
+		}
+		return err
+	}
+
 	if downgradeOnDestroy {
 		log.Printf("[INFO] Downgrading '%s' membership for '%s' to '%s'", orgName, username, downgradeTo)
 
@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -248,3 +252,317 @@ func testAccGithubMembershipTheSame(orig, other *github.Membership) resource.Tes
 		return nil
 	}
 }
+
+func TestResourceGithubMembershipUpdate(t *testing.T) {
+	newData := func(t *testing.T) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, resourceGithubMembership().Schema, map[string]interface{}{
+			"username": "octocat",
+			"role":     "admin",
+		})
+		d.SetId("example:octocat")
+		return d
+	}
+
+	newMeta := func(ts string) *Owner {
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts + "/")
+		client.BaseURL = u
+		return &Owner{name: "example", v3client: client, IsOrganization: true}
+	}
+
+	t.Run("changes the role of an active member", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/memberships/octocat",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"state": "active", "role": "member"}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/memberships/octocat",
+				ExpectedMethod: "PUT",
+				ExpectedBody:   []byte(`{"role":"admin"}` + "\n"),
+				ResponseBody:   `{"state": "active", "role": "admin"}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/memberships/octocat",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"state": "active", "role": "admin"}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := newData(t)
+		err := resourceGithubMembershipUpdate(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if role := d.Get("role").(string); role != "admin" {
+			t.Errorf("Expected role admin, got %q", role)
+		}
+	})
+
+	t.Run("doesn't re-invite a pending member", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/memberships/octocat",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"state": "pending", "role": "member"}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		err := resourceGithubMembershipUpdate(newData(t), newMeta(ts.URL))
+		if err == nil || !strings.Contains(err.Error(), "while their membership is pending") {
+			t.Fatalf("Expected an error about the pending membership, got %v", err)
+		}
+	})
+}
+
+func TestResourceGithubMembershipInvitation(t *testing.T) {
+	newMeta := func(ts string) *Owner {
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts + "/")
+		client.BaseURL = u
+		return &Owner{name: "example", v3client: client, IsOrganization: true}
+	}
+
+	t.Run("invites an email address", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/invitations",
+				ExpectedMethod: "POST",
+				ExpectedBody:   []byte(`{"email":"octocat@example.com","role":"direct_member"}` + "\n"),
+				ResponseBody:   `{"id": 7, "email": "octocat@example.com", "role": "direct_member"}`,
+				StatusCode:     http.StatusCreated,
+			},
+			{
+				ExpectedUri:    "/orgs/example/invitations?per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"id": 7, "email": "Octocat@example.com", "role": "direct_member"}]`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubMembership().Schema, map[string]interface{}{
+			"email": "octocat@example.com",
+		})
+
+		err := resourceGithubMembershipCreate(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "example:octocat@example.com" {
+			t.Errorf("Unexpected ID %q", d.Id())
+		}
+		if !d.Get("pending").(bool) {
+			t.Error("Expected the invitation to be pending")
+		}
+		if id := d.Get("invitation_id").(int); id != 7 {
+			t.Errorf("Expected invitation 7, got %d", id)
+		}
+		if role := d.Get("role").(string); role != "member" {
+			t.Errorf("Expected role member, got %q", role)
+		}
+	})
+
+	t.Run("reads the membership once the invitation is accepted", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/invitations?per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/memberships/octocat",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"state": "active", "role": "admin"}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubMembership().Schema, map[string]interface{}{
+			"email":    "octocat@example.com",
+			"username": "octocat",
+		})
+		d.SetId("example:octocat@example.com")
+
+		err := resourceGithubMembershipRead(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Get("pending").(bool) {
+			t.Error("Expected the membership to be active")
+		}
+		if role := d.Get("role").(string); role != "admin" {
+			t.Errorf("Expected role admin, got %q", role)
+		}
+	})
+
+	t.Run("resolves the member once an email invitation is accepted", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/invitations?per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/failed_invitations?per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"id": 3, "email": "octocat@example.com", "failed_reason": "Invitation expired"}]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/search/users?q=octocat%40example.com+in%3Aemail",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"total_count": 1, "items": [{"login": "octocat"}]}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/memberships/octocat",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"state": "active", "role": "member"}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubMembership().Schema, map[string]interface{}{
+			"email": "octocat@example.com",
+		})
+		d.SetId("example:octocat@example.com")
+		if err := d.Set("invitation_id", 7); err != nil {
+			t.Fatal(err)
+		}
+
+		err := resourceGithubMembershipRead(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "example:octocat@example.com" {
+			t.Fatalf("Expected the membership to remain in state, got ID %q", d.Id())
+		}
+		if username := d.Get("username").(string); username != "octocat" {
+			t.Errorf("Expected username octocat, got %q", username)
+		}
+		if d.Get("pending").(bool) {
+			t.Error("Expected the membership to be active")
+		}
+		if id := d.Get("invitation_id").(int); id != 0 {
+			t.Errorf("Expected the invitation ID to be cleared, got %d", id)
+		}
+	})
+
+	t.Run("keeps an accepted invitation whose member can't be found", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/invitations?per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/failed_invitations?per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/search/users?q=octocat%40example.com+in%3Aemail",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"total_count": 0, "items": []}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubMembership().Schema, map[string]interface{}{
+			"email": "octocat@example.com",
+		})
+		d.SetId("example:octocat@example.com")
+		if err := d.Set("pending", true); err != nil {
+			t.Fatal(err)
+		}
+
+		err := resourceGithubMembershipRead(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() == "" {
+			t.Fatal("Expected the membership to remain in state")
+		}
+		if d.Get("pending").(bool) {
+			t.Error("Expected the invitation to no longer be pending")
+		}
+	})
+
+	t.Run("removes a failed invitation", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/invitations?per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/failed_invitations?per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"id": 7, "email": "octocat@example.com", "failed_reason": "Invitation expired"}]`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubMembership().Schema, map[string]interface{}{
+			"email": "octocat@example.com",
+		})
+		d.SetId("example:octocat@example.com")
+		if err := d.Set("invitation_id", 7); err != nil {
+			t.Fatal(err)
+		}
+
+		err := resourceGithubMembershipRead(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "" {
+			t.Errorf("Expected the failed invitation to be removed from state, got ID %q", d.Id())
+		}
+	})
+
+	t.Run("cancels a pending invitation", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/invitations/7",
+				ExpectedMethod: "DELETE",
+				StatusCode:     http.StatusNoContent,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubMembership().Schema, map[string]interface{}{
+			"email": "octocat@example.com",
+		})
+		d.SetId("example:octocat@example.com")
+		if err := d.Set("invitation_id", 7); err != nil {
+			t.Fatal(err)
+		}
+
+		err := resourceGithubMembershipDelete(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+}
@@ -0,0 +1,193 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The bypass request endpoints are not supported by go-github yet, so
+// requests are made with the generic client.
+
+type secretScanningBypassRequest struct {
+	ID        int64  `json:"id"`
+	Number    int64  `json:"number"`
+	Status    string `json:"status"`
+	Requester struct {
+		ActorID   int64  `json:"actor_id"`
+		ActorName string `json:"actor_name"`
+	} `json:"requester"`
+	RequestComment string            `json:"request_comment"`
+	ExpiresAt      *github.Timestamp `json:"expires_at"`
+	HTMLURL        string            `json:"html_url"`
+}
+
+type secretScanningBypassReview struct {
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+func resourceGithubOrganizationBypassRequestApproval() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationBypassRequestApprovalCreate,
+		Read:   resourceGithubOrganizationBypassRequestApprovalRead,
+		Delete: resourceGithubOrganizationBypassRequestApprovalDelete,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository the bypass request was made in.",
+			},
+			"bypass_request_number": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The number of the secret scanning push protection bypass request.",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"approve", "deny"}, false), "status"),
+				Description:      "The review of the bypass request. Can be one of 'approve' or 'deny'.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "A message explaining the review.",
+			},
+			"request_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the bypass request, e.g. 'approved', 'denied' or 'expired'.",
+			},
+			"requester_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The handle of the user who requested the bypass.",
+			},
+			"request_comment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The comment the requester left on the bypass request.",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the bypass request expires.",
+			},
+			"html_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the bypass request.",
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationBypassRequestApprovalCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	repoName := d.Get("repository").(string)
+	number := int64(d.Get("bypass_request_number").(int))
+
+	review := &secretScanningBypassReview{
+		Status:  d.Get("status").(string),
+		Message: d.Get("message").(string),
+	}
+
+	u := fmt.Sprintf("repos/%s/%s/bypass-requests/secret-scanning/%d", orgName, repoName, number)
+	req, err := client.NewRequest("PATCH", u, review)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reviewing bypass request %s/%s#%d: %s", orgName, repoName, number, review.Status)
+	_, err = client.Do(ctx, req, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(repoName, strconv.FormatInt(number, 10)))
+
+	return resourceGithubOrganizationBypassRequestApprovalRead(d, meta)
+}
+
+func resourceGithubOrganizationBypassRequestApprovalRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repoName, number, err := parseTwoPartID(d.Id(), "repository", "bypass_request_number")
+	if err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("repos/%s/%s/bypass-requests/secret-scanning/%s", orgName, repoName, number)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+
+	request := new(secretScanningBypassRequest)
+	_, err = client.Do(ctx, req, request)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing bypass request approval %s/%s from state because the request no longer exists in GitHub",
+					orgName, d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	expiresAt := ""
+	if request.ExpiresAt != nil {
+		expiresAt = request.ExpiresAt.String()
+	}
+
+	if err = d.Set("request_status", request.Status); err != nil {
+		return err
+	}
+	if err = d.Set("requester_name", request.Requester.ActorName); err != nil {
+		return err
+	}
+	if err = d.Set("request_comment", request.RequestComment); err != nil {
+		return err
+	}
+	if err = d.Set("expires_at", expiresAt); err != nil {
+		return err
+	}
+	return d.Set("html_url", request.HTMLURL)
+}
+
+func resourceGithubOrganizationBypassRequestApprovalDelete(d *schema.ResourceData, meta interface{}) error {
+	// A review can't be taken back, so destroying the resource only removes
+	// it from state.
+	log.Printf("[INFO] Removing bypass request approval %s/%s from state, the review is left in place",
+		meta.(*Owner).name, d.Id())
+	return nil
+}
@@ -0,0 +1,58 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubOrganizationBypassRequestApprovalCreate(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/example/hello-world/bypass-requests/secret-scanning/42",
+			ExpectedMethod: "PATCH",
+			ResponseBody:   `{"status": "approved"}`,
+			StatusCode:     http.StatusOK,
+		},
+		{
+			ExpectedUri:    "/repos/example/hello-world/bypass-requests/secret-scanning/42",
+			ExpectedMethod: "GET",
+			ResponseBody:   `{"id": 7, "number": 42, "status": "approved", "requester": {"actor_id": 12, "actor_name": "octocat"}, "request_comment": "Test fixture", "expires_at": "2024-05-08T12:00:00Z", "html_url": "https://github.com/example/hello-world/exemptions/42"}`,
+			StatusCode:     http.StatusOK,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubOrganizationBypassRequestApproval().Schema, map[string]interface{}{
+		"repository":            "hello-world",
+		"bypass_request_number": 42,
+		"status":                "approve",
+	})
+
+	err := resourceGithubOrganizationBypassRequestApprovalCreate(d, meta)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	if d.Id() != "hello-world:42" {
+		t.Errorf("Unexpected ID %q", d.Id())
+	}
+	if status := d.Get("request_status").(string); status != "approved" {
+		t.Errorf("Expected request status approved, got %q", status)
+	}
+	if requester := d.Get("requester_name").(string); requester != "octocat" {
+		t.Errorf("Expected requester octocat, got %q", requester)
+	}
+	if expiresAt := d.Get("expires_at").(string); expiresAt != "2024-05-08 12:00:00 +0000 UTC" {
+		t.Errorf("Unexpected expires_at %q", expiresAt)
+	}
+}
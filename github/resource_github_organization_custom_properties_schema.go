@@ -0,0 +1,305 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubOrganizationCustomPropertiesSchema() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationCustomPropertiesSchemaCreateOrUpdate,
+		Read:   resourceGithubOrganizationCustomPropertiesSchemaRead,
+		Update: resourceGithubOrganizationCustomPropertiesSchemaCreateOrUpdate,
+		Delete: resourceGithubOrganizationCustomPropertiesSchemaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"property": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The custom property definitions of the organization. Properties not declared here are removed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the custom property.",
+						},
+						"value_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+								"string", "single_select", "multi_select", "true_false",
+							}, false), "value_type"),
+							Description: "The type of the value of the custom property. Can be one of 'string', 'single_select', 'multi_select' or 'true_false'.",
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the custom property is required.",
+						},
+						"default_value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The default value of the custom property.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A short description of the custom property.",
+						},
+						"allowed_values": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "An ordered list of the allowed values of the custom property.",
+						},
+						"values_editable_by": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "org_actors",
+							ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+								"org_actors", "org_and_repo_actors",
+							}, false), "values_editable_by"),
+							Description: "Who can edit the values of the custom property. Can be one of 'org_actors' or 'org_and_repo_actors'.",
+						},
+					},
+				},
+			},
+			"allow_removing_properties_in_use": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether custom properties that are still set on repositories may be removed.",
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationCustomPropertiesSchemaRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	properties, _, err := client.Organizations.GetAllCustomProperties(ctx, orgName)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("property", flattenCustomProperties(properties))
+}
+
+func resourceGithubOrganizationCustomPropertiesSchemaCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	declared := expandCustomProperties(d.Get("property").(*schema.Set).List())
+
+	existing, _, err := client.Organizations.GetAllCustomProperties(ctx, orgName)
+	if err != nil {
+		return err
+	}
+
+	declaredNames := make(map[string]bool, len(declared))
+	for _, property := range declared {
+		declaredNames[strings.ToLower(property.GetPropertyName())] = true
+	}
+
+	var undeclared []string
+	for _, property := range existing {
+		if !declaredNames[strings.ToLower(property.GetPropertyName())] {
+			undeclared = append(undeclared, property.GetPropertyName())
+		}
+	}
+
+	// Check all removals up front so that a property still in use doesn't
+	// leave the schema half applied.
+	if !d.Get("allow_removing_properties_in_use").(bool) {
+		err = checkCustomPropertiesNotInUse(ctx, client, orgName, undeclared)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(declared) > 0 {
+		log.Printf("[DEBUG] Creating or updating %d custom properties for organization %s", len(declared), orgName)
+		_, _, err = client.Organizations.CreateOrUpdateCustomProperties(ctx, orgName, declared)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, name := range undeclared {
+		log.Printf("[DEBUG] Removing undeclared custom property %s from organization %s", name, orgName)
+		_, err = client.Organizations.RemoveCustomProperty(ctx, orgName, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(orgName)
+
+	return resourceGithubOrganizationCustomPropertiesSchemaRead(d, meta)
+}
+
+func resourceGithubOrganizationCustomPropertiesSchemaDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	var names []string
+	for _, property := range expandCustomProperties(d.Get("property").(*schema.Set).List()) {
+		names = append(names, property.GetPropertyName())
+	}
+
+	if !d.Get("allow_removing_properties_in_use").(bool) {
+		err = checkCustomPropertiesNotInUse(ctx, client, orgName, names)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, name := range names {
+		log.Printf("[DEBUG] Removing custom property %s from organization %s", name, orgName)
+		_, err = client.Organizations.RemoveCustomProperty(ctx, orgName, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkCustomPropertiesNotInUse returns an error naming the properties that are
+// still set on at least one repository of the organization.
+func checkCustomPropertiesNotInUse(ctx context.Context, client *github.Client, orgName string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	removed := make(map[string]string, len(names))
+	for _, name := range names {
+		removed[strings.ToLower(name)] = name
+	}
+
+	inUse := make(map[string][]string)
+	options := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+
+	for {
+		repos, resp, err := client.Organizations.ListCustomPropertyValues(ctx, orgName, options)
+		if err != nil {
+			return err
+		}
+
+		for _, repo := range repos {
+			for _, value := range repo.Properties {
+				name, ok := removed[strings.ToLower(value.PropertyName)]
+				if ok && value.Value != nil {
+					inUse[name] = append(inUse[name], repo.RepositoryName)
+				}
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	if len(inUse) == 0 {
+		return nil
+	}
+
+	var details []string
+	for name, repos := range inUse {
+		details = append(details, fmt.Sprintf("%s (%s)", name, strings.Join(repos, ", ")))
+	}
+	sort.Strings(details)
+
+	return fmt.Errorf("refusing to remove custom properties that are still set on repositories: %s; "+
+		"unset them first or set allow_removing_properties_in_use", strings.Join(details, "; "))
+}
+
+func expandCustomProperties(input []interface{}) []*github.CustomProperty {
+	properties := make([]*github.CustomProperty, 0, len(input))
+
+	for _, v := range input {
+		m := v.(map[string]interface{})
+
+		property := &github.CustomProperty{
+			PropertyName:     github.String(m["name"].(string)),
+			ValueType:        m["value_type"].(string),
+			Required:         github.Bool(m["required"].(bool)),
+			ValuesEditableBy: github.String(m["values_editable_by"].(string)),
+		}
+
+		if v, ok := m["default_value"].(string); ok && v != "" {
+			property.DefaultValue = github.String(v)
+		}
+		if v, ok := m["description"].(string); ok && v != "" {
+			property.Description = github.String(v)
+		}
+
+		for _, value := range m["allowed_values"].([]interface{}) {
+			property.AllowedValues = append(property.AllowedValues, value.(string))
+		}
+
+		properties = append(properties, property)
+	}
+
+	return properties
+}
+
+func flattenCustomProperties(properties []*github.CustomProperty) []interface{} {
+	result := make([]interface{}, 0, len(properties))
+
+	for _, property := range properties {
+		valuesEditableBy := property.GetValuesEditableBy()
+		if valuesEditableBy == "" {
+			valuesEditableBy = "org_actors"
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":               property.GetPropertyName(),
+			"value_type":         property.ValueType,
+			"required":           property.GetRequired(),
+			"default_value":      property.GetDefaultValue(),
+			"description":        property.GetDescription(),
+			"allowed_values":     property.AllowedValues,
+			"values_editable_by": valuesEditableBy,
+		})
+	}
+
+	return result
+}
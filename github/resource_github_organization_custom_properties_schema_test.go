@@ -0,0 +1,126 @@
+package github
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationCustomPropertiesSchema(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("manages custom properties authoritatively without error", func(t *testing.T) {
+
+		configs := map[string]string{
+			"before": fmt.Sprintf(`
+				resource "github_organization_custom_properties_schema" "test" {
+				  property {
+				    name        = "tf-acc-test-team-%[1]s"
+				    value_type  = "string"
+				    description = "Owning team"
+				  }
+
+				  property {
+				    name           = "tf-acc-test-env-%[1]s"
+				    value_type     = "single_select"
+				    allowed_values = ["production", "staging"]
+				  }
+				}
+			`, randomID),
+			"after": fmt.Sprintf(`
+				resource "github_organization_custom_properties_schema" "test" {
+				  property {
+				    name        = "tf-acc-test-team-%[1]s"
+				    value_type  = "string"
+				    description = "Owning team"
+				  }
+				}
+			`, randomID),
+		}
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(
+					"github_organization_custom_properties_schema.test", "property.#",
+					"2",
+				),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(
+					"github_organization_custom_properties_schema.test", "property.#",
+					"1",
+				),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: configs["before"],
+						Check:  checks["before"],
+					},
+					{
+						Config: configs["after"],
+						Check:  checks["after"],
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
+
+func TestExpandAndFlattenCustomProperties(t *testing.T) {
+	properties := []*github.CustomProperty{
+		{
+			PropertyName:     github.String("team"),
+			ValueType:        "string",
+			Required:         github.Bool(true),
+			DefaultValue:     github.String("platform"),
+			Description:      github.String("Owning team"),
+			ValuesEditableBy: github.String("org_and_repo_actors"),
+		},
+		{
+			PropertyName:     github.String("environment"),
+			ValueType:        "single_select",
+			Required:         github.Bool(false),
+			AllowedValues:    []string{"production", "staging"},
+			ValuesEditableBy: github.String("org_actors"),
+		},
+	}
+
+	flattened := flattenCustomProperties(properties)
+	for _, v := range flattened {
+		// Mirror how the SDK hands list attributes back to expand.
+		m := v.(map[string]interface{})
+		var allowed []interface{}
+		for _, value := range m["allowed_values"].([]string) {
+			allowed = append(allowed, value)
+		}
+		m["allowed_values"] = allowed
+	}
+
+	expanded := expandCustomProperties(flattened)
+	if !reflect.DeepEqual(expanded, properties) {
+		t.Fatalf("Expected %v, got %v", properties, expanded)
+	}
+}
@@ -0,0 +1,142 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// interactionLimitOptions represents a request to set interaction limits,
+// as go-github can't set when the limits expire.
+type interactionLimitOptions struct {
+	Limit  string `json:"limit"`
+	Expiry string `json:"expiry,omitempty"`
+}
+
+func interactionLimitsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"limit": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"existing_users", "contributors_only", "collaborators_only"}, false), "limit"),
+			Description:      "The type of users allowed to interact. Can be one of 'existing_users', 'contributors_only' or 'collaborators_only'.",
+		},
+		"expiry": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "one_day",
+			ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"one_day", "three_days", "one_week", "one_month", "six_months"}, false), "expiry"),
+			Description:      "How long the limits apply for, from when they are set. Can be one of 'one_day', 'three_days', 'one_week', 'one_month' or 'six_months'.",
+		},
+		"expires_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the limits expire.",
+		},
+	}
+}
+
+// setInteractionLimits sets the interaction limits at the given URL.
+func setInteractionLimits(ctx context.Context, client *github.Client, u string, d *schema.ResourceData) error {
+	req, err := client.NewRequest("PUT", u, &interactionLimitOptions{
+		Limit:  d.Get("limit").(string),
+		Expiry: d.Get("expiry").(string),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
+
+// readInteractionLimits sets the interaction limits at the given URL, and
+// removes the resource from state if there are none of the given origin,
+// e.g. because they expired.
+func readInteractionLimits(ctx context.Context, client *github.Client, u, origin string, d *schema.ResourceData) error {
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+
+	restriction := new(github.InteractionRestriction)
+	_, err = client.Do(ctx, req, restriction)
+	if err != nil {
+		return err
+	}
+
+	if restriction.GetLimit() == "" || restriction.GetOrigin() != origin {
+		log.Printf("[INFO] Removing interaction limits %s from state because they expired or were removed", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("limit", restriction.GetLimit()); err != nil {
+		return err
+	}
+	return d.Set("expires_at", restriction.GetExpiresAt().String())
+}
+
+func resourceGithubOrganizationInteractionLimits() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationInteractionLimitsCreateOrUpdate,
+		Read:   resourceGithubOrganizationInteractionLimitsRead,
+		Update: resourceGithubOrganizationInteractionLimitsCreateOrUpdate,
+		Delete: resourceGithubOrganizationInteractionLimitsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: interactionLimitsSchema(),
+	}
+}
+
+func resourceGithubOrganizationInteractionLimitsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	log.Printf("[DEBUG] Limiting interactions with the repositories of %s to %s", orgName, d.Get("limit").(string))
+	err = setInteractionLimits(ctx, client, fmt.Sprintf("orgs/%s/interaction-limits", orgName), d)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(orgName)
+
+	return resourceGithubOrganizationInteractionLimitsRead(d, meta)
+}
+
+func resourceGithubOrganizationInteractionLimitsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	return readInteractionLimits(ctx, client, fmt.Sprintf("orgs/%s/interaction-limits", d.Id()), "organization", d)
+}
+
+func resourceGithubOrganizationInteractionLimitsDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	_, err = client.Interactions.RemoveRestrictionsFromOrg(ctx, d.Id())
+	return err
+}
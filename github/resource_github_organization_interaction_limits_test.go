@@ -0,0 +1,80 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubOrganizationInteractionLimits(t *testing.T) {
+	newMeta := func(ts string) *Owner {
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts + "/")
+		client.BaseURL = u
+		return &Owner{name: "example", v3client: client, IsOrganization: true}
+	}
+
+	t.Run("limits interactions until they expire", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/interaction-limits",
+				ExpectedMethod: "PUT",
+				ExpectedBody:   []byte(`{"limit":"collaborators_only","expiry":"one_week"}` + "\n"),
+				ResponseBody:   `{"limit": "collaborators_only", "origin": "organization", "expires_at": "2024-01-08T00:00:00Z"}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/interaction-limits",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"limit": "collaborators_only", "origin": "organization", "expires_at": "2024-01-08T00:00:00Z"}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubOrganizationInteractionLimits().Schema, map[string]interface{}{
+			"limit":  "collaborators_only",
+			"expiry": "one_week",
+		})
+
+		err := resourceGithubOrganizationInteractionLimitsCreateOrUpdate(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "example" {
+			t.Errorf("Unexpected ID %q", d.Id())
+		}
+		if expiresAt := d.Get("expires_at").(string); expiresAt != "2024-01-08 00:00:00 +0000 UTC" {
+			t.Errorf("Unexpected expiry %q", expiresAt)
+		}
+	})
+
+	t.Run("removes expired limits from state", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/interaction-limits",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubOrganizationInteractionLimits().Schema, map[string]interface{}{
+			"limit": "existing_users",
+		})
+		d.SetId("example")
+
+		err := resourceGithubOrganizationInteractionLimitsRead(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if d.Id() != "" {
+			t.Errorf("Expected the limits to be removed from state, got ID %q", d.Id())
+		}
+	})
+}
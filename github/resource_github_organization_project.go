@@ -17,6 +17,9 @@ func resourceGithubOrganizationProject() *schema.Resource {
 		Read:   resourceGithubOrganizationProjectRead,
 		Update: resourceGithubOrganizationProjectUpdate,
 		Delete: resourceGithubOrganizationProjectDelete,
+
+		DeprecationMessage: classicProjectDeprecationMessage,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -0,0 +1,203 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// organizationRoleOptions mirrors github.CreateOrUpdateOrgRoleOptions with the
+// addition of base_role, which is accepted by the API but not exposed by the
+// go-github library.
+type organizationRoleOptions struct {
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	BaseRole    *string  `json:"base_role,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+func resourceGithubOrganizationRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationRoleCreate,
+		Read:   resourceGithubOrganizationRoleRead,
+		Update: resourceGithubOrganizationRoleUpdate,
+		Delete: resourceGithubOrganizationRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the custom organization role.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the custom organization role.",
+			},
+			"base_role": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The system repository role from which the organization role inherits repository permissions.",
+				ValidateDiagFunc: validateValueFunc([]string{"read", "triage", "write", "maintain", "admin"}),
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    1, // At least one permission should be passed.
+				Description: "The permissions for the custom organization role.",
+			},
+		},
+	}
+}
+
+func expandOrganizationRoleOptions(d *schema.ResourceData) *organizationRoleOptions {
+	permissions := d.Get("permissions").(*schema.Set).List()
+	permissionsStr := make([]string, len(permissions))
+	for i, v := range permissions {
+		permissionsStr[i] = v.(string)
+	}
+
+	opts := &organizationRoleOptions{
+		Name:        github.String(d.Get("name").(string)),
+		Description: github.String(d.Get("description").(string)),
+		Permissions: permissionsStr,
+	}
+	if baseRole, ok := d.GetOk("base_role"); ok {
+		opts.BaseRole = github.String(baseRole.(string))
+	}
+
+	return opts
+}
+
+func resourceGithubOrganizationRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	req, err := client.NewRequest("POST", fmt.Sprintf("orgs/%v/organization-roles", orgName), expandOrganizationRoleOptions(d))
+	if err != nil {
+		return err
+	}
+
+	role := new(github.CustomOrgRoles)
+	_, err = client.Do(ctx, req, role)
+	if err != nil {
+		return fmt.Errorf("error creating GitHub organization role %s (%s): %s", orgName, d.Get("name").(string), err)
+	}
+
+	d.SetId(fmt.Sprint(role.GetID()))
+	return resourceGithubOrganizationRoleRead(d, meta)
+}
+
+func resourceGithubOrganizationRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+	orgName := meta.(*Owner).name
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	roleID := d.Id()
+
+	// ListRoles returns all organization roles, including the predefined ones.
+	// There is an API endpoint for getting a single organization role, but it is
+	// not implemented in the go-github library.
+	roleList, _, err := client.Organizations.ListRoles(ctx, orgName)
+	if err != nil {
+		return fmt.Errorf("error querying GitHub organization roles %s: %s", orgName, err)
+	}
+
+	var role *github.CustomOrgRoles
+	for _, r := range roleList.CustomRepoRoles {
+		if fmt.Sprint(r.GetID()) == roleID {
+			role = r
+			break
+		}
+	}
+
+	if role == nil {
+		log.Printf("[WARN] GitHub organization role (%s/%s) not found, removing from state", orgName, roleID)
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("name", role.Name); err != nil {
+		return err
+	}
+	if err = d.Set("description", role.Description); err != nil {
+		return err
+	}
+	if err = d.Set("base_role", role.BaseRole); err != nil {
+		return err
+	}
+	if err = d.Set("permissions", role.Permissions); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+	orgName := meta.(*Owner).name
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+	roleIDStr := d.Id()
+	roleID, err := strconv.ParseInt(roleIDStr, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(roleIDStr, err)
+	}
+
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("orgs/%v/organization-roles/%v", orgName, roleID), expandOrganizationRoleOptions(d))
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("error updating GitHub organization role %s (%d): %s", orgName, roleID, err)
+	}
+
+	return resourceGithubOrganizationRoleRead(d, meta)
+}
+
+func resourceGithubOrganizationRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+	orgName := meta.(*Owner).name
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+	roleIDStr := d.Id()
+	roleID, err := strconv.ParseInt(roleIDStr, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(roleIDStr, err)
+	}
+
+	_, err = client.Organizations.DeleteCustomOrgRole(ctx, orgName, roleID)
+	if err != nil {
+		return fmt.Errorf("error deleting GitHub organization role %s (%d): %s", orgName, roleID, err)
+	}
+
+	return nil
+}
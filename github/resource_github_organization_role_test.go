@@ -0,0 +1,101 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationRole(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("creates and updates organization role without error", func(t *testing.T) {
+
+		configs := map[string]string{
+			"before": fmt.Sprintf(`
+				resource "github_organization_role" "test" {
+					name        = "tf-acc-test-%s"
+					description = "Test role description"
+					permissions = [
+						"read_organization_custom_org_role",
+					]
+				}
+			`, randomID),
+			"after": fmt.Sprintf(`
+				resource "github_organization_role" "test" {
+					name        = "tf-acc-test-%s"
+					description = "Updated test role description"
+					base_role   = "read"
+					permissions = [
+						"read_organization_custom_org_role",
+						"read_organization_custom_repo_role",
+					]
+				}
+			`, randomID),
+		}
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(
+					"github_organization_role.test", "name",
+					fmt.Sprintf(`tf-acc-test-%s`, randomID),
+				),
+				resource.TestCheckResourceAttr(
+					"github_organization_role.test", "permissions.#",
+					"1",
+				),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(
+					"github_organization_role.test", "description",
+					"Updated test role description",
+				),
+				resource.TestCheckResourceAttr(
+					"github_organization_role.test", "base_role",
+					"read",
+				),
+				resource.TestCheckResourceAttr(
+					"github_organization_role.test", "permissions.#",
+					"2",
+				),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: configs["before"],
+						Check:  checks["before"],
+					},
+					{
+						Config: configs["after"],
+						Check:  checks["after"],
+					},
+					{
+						ResourceName:      "github_organization_role.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -6,22 +6,31 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceGithubOrganizationRuleset() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGithubOrganizationRulesetCreate,
-		Read:   resourceGithubOrganizationRulesetRead,
-		Update: resourceGithubOrganizationRulesetUpdate,
-		Delete: resourceGithubOrganizationRulesetDelete,
+		Create:        resourceGithubOrganizationRulesetCreate,
+		Read:          resourceGithubOrganizationRulesetRead,
+		Update:        resourceGithubOrganizationRulesetUpdate,
+		DeleteContext: resourceGithubOrganizationRulesetDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceGithubOrganizationRulesetImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: bypassActorsCustomizeDiff,
+
 		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
@@ -52,8 +61,14 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"actor_id": {
 							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "The ID of the actor that can bypass a ruleset. When `actor_type` is `OrganizationAdmin`, this should be set to `1`.",
+							Optional:    true,
+							Computed:    true,
+							Description: "The ID of the actor that can bypass a ruleset. Required unless `actor_slug` is set. When `actor_type` is `OrganizationAdmin`, this should be set to `1`.",
+						},
+						"actor_slug": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The slug of the team or GitHub App that can bypass a ruleset, resolved to `actor_id` by the provider. Only valid when `actor_type` is `Team` or `Integration`.",
 						},
 						"actor_type": {
 							Type:         schema.TypeString,
@@ -84,7 +99,7 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 				Type:        schema.TypeList,
 				Optional:    true,
 				MaxItems:    1,
-				Description: "Parameters for an organization ruleset condition. `ref_name` is required alongside one of `repository_name` or `repository_id`.",
+				Description: "Parameters for an organization ruleset condition. `ref_name` is required alongside one of `repository_name`, `repository_id` or `repository_property`.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"ref_name": {
@@ -97,17 +112,13 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 										Type:        schema.TypeList,
 										Required:    true,
 										Description: "Array of ref names or patterns to include. One of these patterns must match for the condition to pass. Also accepts `~DEFAULT_BRANCH` to include the default branch or `~ALL` to include all branches.",
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
+										Elem:        rulesetRefNamePatternSchema(),
 									},
 									"exclude": {
 										Type:        schema.TypeList,
 										Required:    true,
 										Description: "Array of ref names or patterns to exclude. The condition will not pass if any of these patterns match.",
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
+										Elem:        rulesetRefNamePatternSchema(),
 									},
 								},
 							},
@@ -116,8 +127,8 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 							Type:         schema.TypeList,
 							Optional:     true,
 							MaxItems:     1,
-							ExactlyOneOf: []string{"conditions.0.repository_id"},
-							AtLeastOneOf: []string{"conditions.0.repository_id"},
+							ExactlyOneOf: []string{"conditions.0.repository_id", "conditions.0.repository_property"},
+							AtLeastOneOf: []string{"conditions.0.repository_id", "conditions.0.repository_property"},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"include": {
@@ -153,6 +164,28 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 								Type: schema.TypeInt,
 							},
 						},
+						"repository_property": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Target repositories by the values of their custom properties.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "The repository properties and values to include. All of these properties must match for the condition to pass.",
+										Elem:        rulesetRepositoryPropertyTargetSchema(),
+									},
+									"exclude": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "The repository properties and values to exclude. The condition will not pass if any of these properties match.",
+										Elem:        rulesetRepositoryPropertyTargetSchema(),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -173,6 +206,13 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 							Optional:    true,
 							Description: "Only allow users with bypass permission to update matching refs.",
 						},
+						"update_allows_fetch_and_merge": {
+							Type:         schema.TypeBool,
+							Optional:     true,
+							Default:      false,
+							RequiredWith: []string{"rules.0.update"},
+							Description:  "Branch can pull changes from its upstream repository. This is only applicable to forked repositories. Requires `update` to be set to `true`.",
+						},
 						"deletion": {
 							Type:        schema.TypeBool,
 							Optional:    true,
@@ -225,6 +265,17 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 										Default:     false,
 										Description: "All conversations on code must be resolved before a pull request can be merged. Defaults to `false`.",
 									},
+									"allowed_merge_methods": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Computed:    true,
+										MinItems:    1,
+										Description: "The merge methods allowed for pull requests targeting a matching branch. Any of `merge`, `squash` and `rebase`. Defaults to all of them.",
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"merge", "squash", "rebase"}, false), "allowed_merge_methods"),
+										},
+									},
 								},
 							},
 						},
@@ -261,6 +312,11 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 										Optional:    true,
 										Description: "Whether pull requests targeting a matching branch must be tested with the latest code. This setting will not take effect unless at least one status check is enabled. Defaults to `false`.",
 									},
+									"do_not_enforce_on_create": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Allow repositories and branches to be created if a check would otherwise prohibit it. Defaults to `false`.",
+									},
 								},
 							},
 						},
@@ -421,6 +477,43 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 								},
 							},
 						},
+						"code_scanning": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Choose which tools must provide code scanning results before the reference is updated. When configured, code scanning must be enabled and have results for both the commit and the reference being updated.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code_scanning_tool": {
+										Type:        schema.TypeSet,
+										MinItems:    1,
+										Required:    true,
+										Description: "Tools that must provide code scanning results for this rule to pass.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tool": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "The name of a code scanning tool.",
+												},
+												"alerts_threshold": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Description:  "The severity level at which code scanning results that raise alerts block a reference update. Can be one of: `none`, `errors`, `errors_and_warnings`, `all`.",
+													ValidateFunc: validation.StringInSlice([]string{"none", "errors", "errors_and_warnings", "all"}, false),
+												},
+												"security_alerts_threshold": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Description:  "The severity level at which code scanning results that raise security alerts block a reference update. Can be one of: `none`, `critical`, `high_or_higher`, `medium_or_higher`, `all`.",
+													ValidateFunc: validation.StringInSlice([]string{"none", "critical", "high_or_higher", "medium_or_higher", "all"}, false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"required_workflows": {
 							Type:        schema.TypeList,
 							MaxItems:    1,
@@ -451,6 +544,11 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 													Default:     "master",
 													Description: "The ref (branch or tag) of the workflow file to use.",
 												},
+												"sha": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The commit SHA of the workflow file to use.",
+												},
 											},
 										},
 									},
@@ -460,6 +558,19 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 					},
 				},
 			},
+			"raw_rules_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "A JSON array of rules of types not supported by `rules`, in the format of the GitHub API. Once set, rules of such types added outside of Terraform are detected as drift.",
+			},
+			"ruleset_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The JSON of the ruleset as returned by the GitHub API.",
+			},
+			"destroy_grace_period": rulesetDestroyGracePeriodSchema(),
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -473,14 +584,30 @@ func resourceGithubOrganizationRulesetCreate(d *schema.ResourceData, meta interf
 
 	owner := meta.(*Owner).name
 
+	err := resolveBypassActorSlugs(context.Background(), d, meta)
+	if err != nil {
+		return err
+	}
+
 	rulesetReq := resourceGithubRulesetObject(d, owner)
+	rawRules, err := expandRawRules(d.Get("raw_rules_json").(string))
+	if err != nil {
+		return err
+	}
+	rulesetReq.Rules = append(rulesetReq.Rules, rawRules...)
 
 	ctx := context.Background()
 
-	var ruleset *github.Ruleset
-	var err error
+	rulesets, _, err := client.Organizations.GetAllOrganizationRulesets(ctx, owner)
+	if err != nil {
+		return err
+	}
+	if existing := findRulesetByName(rulesets, rulesetReq.Name); existing != nil {
+		return rulesetExistsError(existing, fmt.Sprintf("organization %s", owner), "github_organization_ruleset",
+			strconv.FormatInt(existing.GetID(), 10))
+	}
 
-	ruleset, _, err = client.Organizations.CreateOrganizationRuleset(ctx, owner, rulesetReq)
+	ruleset, _, _, _, err := doRulesetRequest(ctx, client, "POST", fmt.Sprintf("orgs/%s/rulesets", owner), rulesetReq)
 	if err != nil {
 		return err
 	}
@@ -503,10 +630,7 @@ func resourceGithubOrganizationRulesetRead(d *schema.ResourceData, meta interfac
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
 
-	var ruleset *github.Ruleset
-	var resp *github.Response
-
-	ruleset, resp, err = client.Organizations.GetOrganizationRuleset(ctx, owner, rulesetID)
+	ruleset, otherRules, rulesetJSON, resp, err := getRulesetJSON(ctx, client, fmt.Sprintf("orgs/%s/rulesets/%d", owner, rulesetID))
 	if err != nil {
 		if ghErr, ok := err.(*github.ErrorResponse); ok {
 			if ghErr.Response.StatusCode == http.StatusNotModified {
@@ -519,17 +643,29 @@ func resourceGithubOrganizationRulesetRead(d *schema.ResourceData, meta interfac
 				return nil
 			}
 		}
+		return err
 	}
 
 	d.Set("etag", resp.Header.Get("ETag"))
 	d.Set("name", ruleset.Name)
 	d.Set("target", ruleset.GetTarget())
 	d.Set("enforcement", ruleset.Enforcement)
-	d.Set("bypass_actors", flattenBypassActors(ruleset.BypassActors))
+	d.Set("bypass_actors", preserveBypassActorSlugs(flattenBypassActors(ruleset.BypassActors), d.Get("bypass_actors").([]interface{})))
 	d.Set("conditions", flattenConditions(ruleset.GetConditions(), true))
 	d.Set("rules", flattenRules(ruleset.Rules, true))
 	d.Set("node_id", ruleset.GetNodeID())
 	d.Set("ruleset_id", ruleset.ID)
+	d.Set("ruleset_json", string(rulesetJSON))
+
+	// The rules of other types are only read back once raw_rules_json is
+	// used, so configurations that don't use it don't start to drift.
+	if d.Get("raw_rules_json").(string) != "" {
+		rawRules, err := flattenRawRules(otherRules)
+		if err != nil {
+			return err
+		}
+		d.Set("raw_rules_json", rawRules)
+	}
 
 	return nil
 }
@@ -539,7 +675,17 @@ func resourceGithubOrganizationRulesetUpdate(d *schema.ResourceData, meta interf
 
 	owner := meta.(*Owner).name
 
+	err := resolveBypassActorSlugs(context.Background(), d, meta)
+	if err != nil {
+		return err
+	}
+
 	rulesetReq := resourceGithubRulesetObject(d, owner)
+	rawRules, err := expandRawRules(d.Get("raw_rules_json").(string))
+	if err != nil {
+		return err
+	}
+	rulesetReq.Rules = append(rulesetReq.Rules, rawRules...)
 
 	rulesetID, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -548,7 +694,7 @@ func resourceGithubOrganizationRulesetUpdate(d *schema.ResourceData, meta interf
 
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
-	ruleset, _, err := client.Organizations.UpdateOrganizationRuleset(ctx, owner, rulesetID, rulesetReq)
+	ruleset, _, _, _, err := doRulesetRequest(ctx, client, "PUT", fmt.Sprintf("orgs/%s/rulesets/%d", owner, rulesetID), rulesetReq)
 	if err != nil {
 		return err
 	}
@@ -557,19 +703,24 @@ func resourceGithubOrganizationRulesetUpdate(d *schema.ResourceData, meta interf
 	return resourceGithubOrganizationRulesetRead(d, meta)
 }
 
-func resourceGithubOrganizationRulesetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceGithubOrganizationRulesetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 
 	rulesetID, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
-		return unconvertibleIdErr(d.Id(), err)
+		return diag.FromErr(unconvertibleIdErr(d.Id(), err))
+	}
+	ctx = context.WithValue(ctx, ctxId, d.Id())
+
+	err = disableRulesetBeforeDestroy(ctx, client, fmt.Sprintf("orgs/%s/rulesets/%d", owner, rulesetID), d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting organization ruleset: %s: %d", owner, rulesetID)
 	_, err = client.Organizations.DeleteOrganizationRuleset(ctx, owner, rulesetID)
-	return err
+	return diag.FromErr(err)
 }
 
 func resourceGithubOrganizationRulesetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -586,7 +737,7 @@ func resourceGithubOrganizationRulesetImport(d *schema.ResourceData, meta interf
 	owner := meta.(*Owner).name
 	ctx := context.Background()
 
-	ruleset, _, err := client.Organizations.GetOrganizationRuleset(ctx, owner, rulesetID)
+	ruleset, _, _, _, err := getRulesetJSON(ctx, client, fmt.Sprintf("orgs/%s/rulesets/%d", owner, rulesetID))
 	if ruleset == nil || err != nil {
 		return []*schema.ResourceData{d}, err
 	}
@@ -594,3 +745,31 @@ func resourceGithubOrganizationRulesetImport(d *schema.ResourceData, meta interf
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func rulesetRepositoryPropertyTargetSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository property.",
+			},
+			"property_values": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The values to match for the repository property.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"source": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "custom",
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"custom", "system"}, false), "source"),
+				Description:      "The source of the repository property, either `custom` for custom properties or `system` for properties such as `fork`. Defaults to `custom`.",
+			},
+		},
+	}
+}
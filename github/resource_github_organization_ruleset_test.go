@@ -2,11 +2,14 @@ package github
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 	"testing"
 
+	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestGithubOrganizationRulesets(t *testing.T) {
@@ -253,3 +256,128 @@ func TestGithubOrganizationRulesets(t *testing.T) {
 	})
 
 }
+
+func TestOrganizationRulesetCodeScanningRoundTrip(t *testing.T) {
+	rulesetJSON := `{
+  "id": 1,
+  "name": "main",
+  "target": "branch",
+  "enforcement": "active",
+  "rules": [
+    {
+      "type": "code_scanning",
+      "parameters": {
+        "code_scanning_tools": [
+          {"tool": "CodeQL", "alerts_threshold": "errors", "security_alerts_threshold": "high_or_higher"}
+        ]
+      }
+    }
+  ]
+}`
+
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/orgs/example/rulesets",
+			ExpectedMethod: "GET",
+			ResponseBody:   `[]`,
+			StatusCode:     200,
+		},
+		{
+			ExpectedUri:    "/orgs/example/rulesets",
+			ExpectedMethod: "POST",
+			ResponseBody:   rulesetJSON,
+			StatusCode:     201,
+		},
+		{
+			ExpectedUri:    "/orgs/example/rulesets/1",
+			ExpectedMethod: "GET",
+			ResponseBody:   rulesetJSON,
+			StatusCode:     200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	d := schema.TestResourceDataRaw(t, resourceGithubOrganizationRuleset().Schema, map[string]interface{}{
+		"name":        "main",
+		"target":      "branch",
+		"enforcement": "active",
+		"rules": []interface{}{
+			map[string]interface{}{
+				"code_scanning": []interface{}{
+					map[string]interface{}{
+						"code_scanning_tool": []interface{}{
+							map[string]interface{}{
+								"tool":                      "CodeQL",
+								"alerts_threshold":          "errors",
+								"security_alerts_threshold": "high_or_higher",
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	err := resourceGithubOrganizationRulesetCreate(d, &Owner{name: "example", v3client: client, IsOrganization: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	if d.Id() != "1" {
+		t.Errorf("Expected ID 1, got %q", d.Id())
+	}
+	if tool := d.Get("rules.0.code_scanning.0.code_scanning_tool").(*schema.Set).List(); len(tool) != 1 || tool[0].(map[string]interface{})["tool"] != "CodeQL" {
+		t.Errorf("Expected the code_scanning rule to be read back, got %#v", tool)
+	}
+}
+
+func TestOrganizationRulesetReadAllowedMergeMethods(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/orgs/example/rulesets/1",
+			ExpectedMethod: "GET",
+			ResponseBody: `{
+  "id": 1,
+  "name": "main",
+  "target": "branch",
+  "enforcement": "active",
+  "rules": [
+    {
+      "type": "pull_request",
+      "parameters": {
+        "dismiss_stale_reviews_on_push": false,
+        "require_code_owner_review": false,
+        "require_last_push_approval": false,
+        "required_approving_review_count": 1,
+        "required_review_thread_resolution": false,
+        "allowed_merge_methods": ["rebase", "squash"]
+      }
+    }
+  ]
+}`,
+			StatusCode: 200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	d := schema.TestResourceDataRaw(t, resourceGithubOrganizationRuleset().Schema, map[string]interface{}{})
+	d.SetId("1")
+
+	err := resourceGithubOrganizationRulesetRead(d, &Owner{name: "example", v3client: client, IsOrganization: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	methods := d.Get("rules.0.pull_request.0.allowed_merge_methods").(*schema.Set)
+	if methods.Len() != 2 || !methods.Contains("rebase") || !methods.Contains("squash") {
+		t.Errorf("Expected allowed_merge_methods [rebase squash], got %v", methods.List())
+	}
+}
@@ -0,0 +1,185 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The code security configuration endpoints are not supported by go-github
+// yet, so requests are made with the generic client.
+
+type codeSecurityConfigurationDelegatedBypass struct {
+	SecretScanningDelegatedBypass        string                              `json:"secret_scanning_delegated_bypass,omitempty"`
+	SecretScanningDelegatedBypassOptions *codeSecurityDelegatedBypassOptions `json:"secret_scanning_delegated_bypass_options,omitempty"`
+}
+
+type codeSecurityDelegatedBypassOptions struct {
+	Reviewers []*codeSecurityDelegatedBypassReviewer `json:"reviewers"`
+}
+
+type codeSecurityDelegatedBypassReviewer struct {
+	ReviewerID   int64  `json:"reviewer_id"`
+	ReviewerType string `json:"reviewer_type"`
+}
+
+func resourceGithubOrganizationSecretScanningBypassReviewers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationSecretScanningBypassReviewersCreateOrUpdate,
+		Read:   resourceGithubOrganizationSecretScanningBypassReviewersRead,
+		Update: resourceGithubOrganizationSecretScanningBypassReviewersCreateOrUpdate,
+		Delete: resourceGithubOrganizationSecretScanningBypassReviewersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the organization's code security configuration.",
+			},
+			"reviewer": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "A team or role that can review requests to bypass secret scanning push protection.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reviewer_id": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The ID of the team or role.",
+						},
+						"reviewer_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"TEAM", "ROLE"}, false), "reviewer_type"),
+							Description:      "The type of the reviewer. Can be one of 'TEAM' or 'ROLE'.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationSecretScanningBypassReviewersCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	configurationID := int64(d.Get("configuration_id").(int))
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	reviewers := make([]*codeSecurityDelegatedBypassReviewer, 0)
+	for _, v := range d.Get("reviewer").(*schema.Set).List() {
+		reviewer := v.(map[string]interface{})
+		reviewers = append(reviewers, &codeSecurityDelegatedBypassReviewer{
+			ReviewerID:   int64(reviewer["reviewer_id"].(int)),
+			ReviewerType: reviewer["reviewer_type"].(string),
+		})
+	}
+
+	err = updateCodeSecurityConfigurationDelegatedBypass(ctx, meta, configurationID, &codeSecurityConfigurationDelegatedBypass{
+		SecretScanningDelegatedBypass:        "enabled",
+		SecretScanningDelegatedBypassOptions: &codeSecurityDelegatedBypassOptions{Reviewers: reviewers},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(configurationID, 10))
+
+	return resourceGithubOrganizationSecretScanningBypassReviewersRead(d, meta)
+}
+
+func resourceGithubOrganizationSecretScanningBypassReviewersRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	configurationID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+
+	req, err := client.NewRequest("GET", fmt.Sprintf("orgs/%s/code-security/configurations/%d", orgName, configurationID), nil)
+	if err != nil {
+		return err
+	}
+
+	configuration := new(codeSecurityConfigurationDelegatedBypass)
+	_, err = client.Do(ctx, req, configuration)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing secret scanning bypass reviewers of code security configuration %s/%s from state because it no longer exists in GitHub",
+					orgName, d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	reviewers := make([]interface{}, 0)
+	if configuration.SecretScanningDelegatedBypass == "enabled" && configuration.SecretScanningDelegatedBypassOptions != nil {
+		for _, reviewer := range configuration.SecretScanningDelegatedBypassOptions.Reviewers {
+			reviewers = append(reviewers, map[string]interface{}{
+				"reviewer_id":   reviewer.ReviewerID,
+				"reviewer_type": reviewer.ReviewerType,
+			})
+		}
+	}
+
+	if err = d.Set("configuration_id", configurationID); err != nil {
+		return err
+	}
+	return d.Set("reviewer", reviewers)
+}
+
+func resourceGithubOrganizationSecretScanningBypassReviewersDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	configurationID := int64(d.Get("configuration_id").(int))
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	return updateCodeSecurityConfigurationDelegatedBypass(ctx, meta, configurationID, &codeSecurityConfigurationDelegatedBypass{
+		SecretScanningDelegatedBypass: "disabled",
+	})
+}
+
+func updateCodeSecurityConfigurationDelegatedBypass(ctx context.Context, meta interface{}, configurationID int64, body *codeSecurityConfigurationDelegatedBypass) error {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("orgs/%s/code-security/configurations/%d", orgName, configurationID), body)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Setting secret scanning delegated bypass of code security configuration %s/%d to %s",
+		orgName, configurationID, body.SecretScanningDelegatedBypass)
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
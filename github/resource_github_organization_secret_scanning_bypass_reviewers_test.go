@@ -0,0 +1,90 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubOrganizationSecretScanningBypassReviewers(t *testing.T) {
+	t.Run("enables delegated bypass with the given reviewers", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/code-security/configurations/17",
+				ExpectedMethod: "PATCH",
+				ResponseBody:   `{"id": 17}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/code-security/configurations/17",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"id": 17, "secret_scanning_delegated_bypass": "enabled", "secret_scanning_delegated_bypass_options": {"reviewers": [{"reviewer_id": 3, "reviewer_type": "TEAM"}]}}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubOrganizationSecretScanningBypassReviewers().Schema, map[string]interface{}{
+			"configuration_id": 17,
+			"reviewer": []interface{}{
+				map[string]interface{}{"reviewer_id": 3, "reviewer_type": "TEAM"},
+			},
+		})
+
+		err := resourceGithubOrganizationSecretScanningBypassReviewersCreateOrUpdate(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "17" {
+			t.Errorf("Unexpected ID %q", d.Id())
+		}
+		if count := d.Get("reviewer.#").(int); count != 1 {
+			t.Errorf("Expected 1 reviewer, got %d", count)
+		}
+	})
+
+	t.Run("drops the reviewers once delegated bypass is disabled", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/code-security/configurations/17",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"id": 17, "secret_scanning_delegated_bypass": "disabled"}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubOrganizationSecretScanningBypassReviewers().Schema, map[string]interface{}{
+			"configuration_id": 17,
+			"reviewer": []interface{}{
+				map[string]interface{}{"reviewer_id": 3, "reviewer_type": "TEAM"},
+			},
+		})
+		d.SetId("17")
+
+		err := resourceGithubOrganizationSecretScanningBypassReviewersRead(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if count := d.Get("reviewer.#").(int); count != 0 {
+			t.Errorf("Expected no reviewers, got %d", count)
+		}
+	})
+}
@@ -0,0 +1,266 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// codeSecurityConfiguration represents the security settings bundle of an
+// organization's code security configuration. go-github doesn't model code
+// security configurations yet.
+type codeSecurityConfiguration struct {
+	ID                                int64  `json:"id,omitempty"`
+	Name                              string `json:"name,omitempty"`
+	Description                       string `json:"description,omitempty"`
+	TargetType                        string `json:"target_type,omitempty"`
+	AdvancedSecurity                  string `json:"advanced_security,omitempty"`
+	DependencyGraph                   string `json:"dependency_graph,omitempty"`
+	DependencyGraphAutosubmitAction   string `json:"dependency_graph_autosubmit_action,omitempty"`
+	DependabotAlerts                  string `json:"dependabot_alerts,omitempty"`
+	DependabotSecurityUpdates         string `json:"dependabot_security_updates,omitempty"`
+	CodeScanningDefaultSetup          string `json:"code_scanning_default_setup,omitempty"`
+	SecretScanning                    string `json:"secret_scanning,omitempty"`
+	SecretScanningPushProtection      string `json:"secret_scanning_push_protection,omitempty"`
+	SecretScanningValidityChecks      string `json:"secret_scanning_validity_checks,omitempty"`
+	SecretScanningNonProviderPatterns string `json:"secret_scanning_non_provider_patterns,omitempty"`
+	PrivateVulnerabilityReporting     string `json:"private_vulnerability_reporting,omitempty"`
+	Enforcement                       string `json:"enforcement,omitempty"`
+	HTMLURL                           string `json:"html_url,omitempty"`
+}
+
+// features returns the enablement settings of the configuration keyed by
+// their attribute name.
+func (c *codeSecurityConfiguration) features() map[string]*string {
+	return map[string]*string{
+		"advanced_security":                     &c.AdvancedSecurity,
+		"dependency_graph":                      &c.DependencyGraph,
+		"dependency_graph_autosubmit_action":    &c.DependencyGraphAutosubmitAction,
+		"dependabot_alerts":                     &c.DependabotAlerts,
+		"dependabot_security_updates":           &c.DependabotSecurityUpdates,
+		"code_scanning_default_setup":           &c.CodeScanningDefaultSetup,
+		"secret_scanning":                       &c.SecretScanning,
+		"secret_scanning_push_protection":       &c.SecretScanningPushProtection,
+		"secret_scanning_validity_checks":       &c.SecretScanningValidityChecks,
+		"secret_scanning_non_provider_patterns": &c.SecretScanningNonProviderPatterns,
+		"private_vulnerability_reporting":       &c.PrivateVulnerabilityReporting,
+	}
+}
+
+func codeSecurityConfigurationFeatureSchema(feature string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"enabled", "disabled", "not_set"}, false), feature),
+		Description:      fmt.Sprintf("The enablement status of %s. Can be one of 'enabled', 'disabled' or 'not_set'.", feature),
+	}
+}
+
+func resourceGithubOrganizationSecurityConfiguration() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the code security configuration. Must be unique within the organization.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "A description of the code security configuration.",
+		},
+		"enforcement": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "enforced",
+			ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"enforced", "unenforced"}, false), "enforcement"),
+			Description:      "Whether repositories can change the settings of the configuration. Can be one of 'enforced' or 'unenforced'.",
+		},
+		"configuration_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The ID of the code security configuration.",
+		},
+		"target_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of the code security configuration.",
+		},
+		"html_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The URL of the code security configuration.",
+		},
+	}
+	for feature := range (&codeSecurityConfiguration{}).features() {
+		s[feature] = codeSecurityConfigurationFeatureSchema(feature)
+	}
+
+	return &schema.Resource{
+		Create: resourceGithubOrganizationSecurityConfigurationCreate,
+		Read:   resourceGithubOrganizationSecurityConfigurationRead,
+		Update: resourceGithubOrganizationSecurityConfigurationUpdate,
+		Delete: resourceGithubOrganizationSecurityConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: s,
+	}
+}
+
+func expandCodeSecurityConfiguration(d *schema.ResourceData) *codeSecurityConfiguration {
+	configuration := &codeSecurityConfiguration{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Enforcement: d.Get("enforcement").(string),
+	}
+	for feature, value := range configuration.features() {
+		if v, ok := d.GetOk(feature); ok {
+			*value = v.(string)
+		}
+	}
+	return configuration
+}
+
+func resourceGithubOrganizationSecurityConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	req, err := client.NewRequest("POST", fmt.Sprintf("orgs/%s/code-security/configurations", orgName), expandCodeSecurityConfiguration(d))
+	if err != nil {
+		return err
+	}
+
+	configuration := new(codeSecurityConfiguration)
+	_, err = client.Do(ctx, req, configuration)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(configuration.ID, 10))
+
+	return resourceGithubOrganizationSecurityConfigurationRead(d, meta)
+}
+
+func resourceGithubOrganizationSecurityConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	configurationID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+
+	req, err := client.NewRequest("GET", fmt.Sprintf("orgs/%s/code-security/configurations/%d", orgName, configurationID), nil)
+	if err != nil {
+		return err
+	}
+
+	configuration := new(codeSecurityConfiguration)
+	_, err = client.Do(ctx, req, configuration)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing code security configuration %s/%s from state because it no longer exists in GitHub",
+					orgName, d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	if err = d.Set("name", configuration.Name); err != nil {
+		return err
+	}
+	if err = d.Set("description", configuration.Description); err != nil {
+		return err
+	}
+	if err = d.Set("enforcement", configuration.Enforcement); err != nil {
+		return err
+	}
+	for feature, value := range configuration.features() {
+		if err = d.Set(feature, *value); err != nil {
+			return err
+		}
+	}
+	if err = d.Set("configuration_id", configuration.ID); err != nil {
+		return err
+	}
+	if err = d.Set("target_type", configuration.TargetType); err != nil {
+		return err
+	}
+	return d.Set("html_url", configuration.HTMLURL)
+}
+
+func resourceGithubOrganizationSecurityConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	configurationID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("orgs/%s/code-security/configurations/%d", orgName, configurationID), expandCodeSecurityConfiguration(d))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubOrganizationSecurityConfigurationRead(d, meta)
+}
+
+func resourceGithubOrganizationSecurityConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	configurationID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+
+	req, err := client.NewRequest("DELETE", fmt.Sprintf("orgs/%s/code-security/configurations/%d", orgName, configurationID), nil)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting code security configuration %s/%d", orgName, configurationID)
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
@@ -0,0 +1,370 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type codeSecurityConfigurationAttachRequest struct {
+	Scope                 string  `json:"scope"`
+	SelectedRepositoryIDs []int64 `json:"selected_repository_ids,omitempty"`
+}
+
+type codeSecurityConfigurationDetachRequest struct {
+	SelectedRepositoryIDs []int64 `json:"selected_repository_ids"`
+}
+
+type codeSecurityConfigurationDefaultRequest struct {
+	DefaultForNewRepos string `json:"default_for_new_repos"`
+}
+
+type codeSecurityConfigurationDefault struct {
+	DefaultForNewRepos string                     `json:"default_for_new_repos"`
+	Configuration      *codeSecurityConfiguration `json:"configuration"`
+}
+
+type codeSecurityConfigurationRepository struct {
+	Status     string             `json:"status"`
+	Repository *github.Repository `json:"repository"`
+}
+
+// codeSecurityConfigurationAttachedStatuses are the statuses of repositories
+// the configuration is, or is being, applied to.
+var codeSecurityConfigurationAttachedStatuses = map[string]bool{
+	"attached":  true,
+	"attaching": true,
+	"updating":  true,
+	"enforced":  true,
+}
+
+func resourceGithubOrganizationSecurityConfigurationAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationSecurityConfigurationAttachmentCreate,
+		Read:   resourceGithubOrganizationSecurityConfigurationAttachmentRead,
+		Update: resourceGithubOrganizationSecurityConfigurationAttachmentUpdate,
+		Delete: resourceGithubOrganizationSecurityConfigurationAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"configuration_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the code security configuration.",
+			},
+			"scope": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "selected",
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"all", "all_without_configurations", "public", "private_or_internal", "selected"}, false), "scope"),
+				Description:      "The repositories to apply the configuration to. Can be one of 'all', 'all_without_configurations', 'public', 'private_or_internal' or 'selected'.",
+			},
+			"selected_repository_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Set:         schema.HashInt,
+				Description: "The IDs of the repositories to apply the configuration to. Only used when 'scope' is 'selected'.",
+			},
+			"default_for_new_repos": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"all", "none", "private_and_internal", "public"}, false), "default_for_new_repos"),
+				Description:      "The new repositories the configuration is applied to by default. Can be one of 'all', 'none', 'private_and_internal' or 'public'.",
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationSecurityConfigurationAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	configurationID := int64(d.Get("configuration_id").(int))
+	scope := d.Get("scope").(string)
+	repositoryIDs := expandRepositoryIDs(d.Get("selected_repository_ids").(*schema.Set))
+	ctx := context.Background()
+
+	if scope == "selected" && len(repositoryIDs) == 0 {
+		return fmt.Errorf("selected_repository_ids must be set when scope is selected")
+	}
+	if scope != "selected" && len(repositoryIDs) > 0 {
+		return fmt.Errorf("selected_repository_ids can only be set when scope is selected")
+	}
+
+	err = attachCodeSecurityConfiguration(ctx, meta, configurationID, scope, repositoryIDs)
+	if err != nil {
+		return err
+	}
+
+	if defaultForNewRepos, ok := d.GetOk("default_for_new_repos"); ok {
+		err = setCodeSecurityConfigurationDefault(ctx, meta, configurationID, defaultForNewRepos.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(strconv.FormatInt(configurationID, 10))
+
+	return resourceGithubOrganizationSecurityConfigurationAttachmentRead(d, meta)
+}
+
+func resourceGithubOrganizationSecurityConfigurationAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	configurationID := int64(d.Get("configuration_id").(int))
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	attached, err := listCodeSecurityConfigurationRepositoryIDs(ctx, meta, configurationID)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "code security configuration attachment %s", d.Id())
+	}
+
+	// Only the configured repositories are tracked, as the configuration may
+	// also be applied to other repositories, e.g. by default.
+	if d.Get("scope").(string) == "selected" {
+		repositoryIDs := make([]interface{}, 0)
+		for _, v := range d.Get("selected_repository_ids").(*schema.Set).List() {
+			if attached[int64(v.(int))] {
+				repositoryIDs = append(repositoryIDs, v)
+			}
+		}
+		if err = d.Set("selected_repository_ids", schema.NewSet(schema.HashInt, repositoryIDs)); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("default_for_new_repos"); ok {
+		defaultForNewRepos, err := getCodeSecurityConfigurationDefault(ctx, meta, configurationID)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("default_for_new_repos", defaultForNewRepos); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationSecurityConfigurationAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	configurationID := int64(d.Get("configuration_id").(int))
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	if d.HasChange("selected_repository_ids") {
+		oldSet, newSet := setChanges(d.GetChange("selected_repository_ids"))
+		toAdd := expandRepositoryIDs(newSet.Difference(oldSet))
+		toRemove := expandRepositoryIDs(oldSet.Difference(newSet))
+
+		if len(toAdd) > 0 {
+			err = attachCodeSecurityConfiguration(ctx, meta, configurationID, "selected", toAdd)
+			if err != nil {
+				return err
+			}
+		}
+		if len(toRemove) > 0 {
+			err = detachCodeSecurityConfiguration(ctx, meta, toRemove)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("default_for_new_repos") {
+		defaultForNewRepos := d.Get("default_for_new_repos").(string)
+		if defaultForNewRepos == "" {
+			defaultForNewRepos = "none"
+		}
+		err = setCodeSecurityConfigurationDefault(ctx, meta, configurationID, defaultForNewRepos)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubOrganizationSecurityConfigurationAttachmentRead(d, meta)
+}
+
+func resourceGithubOrganizationSecurityConfigurationAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	configurationID := int64(d.Get("configuration_id").(int))
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	if _, ok := d.GetOk("default_for_new_repos"); ok {
+		err = setCodeSecurityConfigurationDefault(ctx, meta, configurationID, "none")
+		if err != nil {
+			return err
+		}
+	}
+
+	var repositoryIDs []int64
+	if d.Get("scope").(string) == "selected" {
+		repositoryIDs = expandRepositoryIDs(d.Get("selected_repository_ids").(*schema.Set))
+	} else {
+		attached, err := listCodeSecurityConfigurationRepositoryIDs(ctx, meta, configurationID)
+		if err != nil {
+			return err
+		}
+		for id := range attached {
+			repositoryIDs = append(repositoryIDs, id)
+		}
+	}
+	if len(repositoryIDs) == 0 {
+		return nil
+	}
+
+	return detachCodeSecurityConfiguration(ctx, meta, repositoryIDs)
+}
+
+func expandRepositoryIDs(set *schema.Set) []int64 {
+	ids := make([]int64, 0, set.Len())
+	for _, v := range set.List() {
+		ids = append(ids, int64(v.(int)))
+	}
+	return ids
+}
+
+func attachCodeSecurityConfiguration(ctx context.Context, meta interface{}, configurationID int64, scope string, repositoryIDs []int64) error {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	u := fmt.Sprintf("orgs/%s/code-security/configurations/%d/attach", orgName, configurationID)
+	req, err := client.NewRequest("POST", u, &codeSecurityConfigurationAttachRequest{
+		Scope:                 scope,
+		SelectedRepositoryIDs: repositoryIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attaching code security configuration %s/%d to %s repositories %v", orgName, configurationID, scope, repositoryIDs)
+	_, err = client.Do(ctx, req, nil)
+	// Attaching happens in the background, which GitHub reports with a 202.
+	if _, ok := err.(*github.AcceptedError); ok {
+		return nil
+	}
+	return err
+}
+
+func detachCodeSecurityConfiguration(ctx context.Context, meta interface{}, repositoryIDs []int64) error {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	u := fmt.Sprintf("orgs/%s/code-security/configurations/detach", orgName)
+	req, err := client.NewRequest("DELETE", u, &codeSecurityConfigurationDetachRequest{
+		SelectedRepositoryIDs: repositoryIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Detaching code security configurations of %s from repositories %v", orgName, repositoryIDs)
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
+
+// listCodeSecurityConfigurationRepositoryIDs returns the IDs of the
+// repositories the configuration is applied to.
+func listCodeSecurityConfigurationRepositoryIDs(ctx context.Context, meta interface{}, configurationID int64) (map[int64]bool, error) {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	params := url.Values{}
+	params.Set("per_page", strconv.Itoa(maxPerPage))
+
+	ids := make(map[int64]bool)
+	for {
+		u := fmt.Sprintf("orgs/%s/code-security/configurations/%d/repositories?%s", orgName, configurationID, params.Encode())
+		req, err := client.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []*codeSecurityConfigurationRepository
+		resp, err := client.Do(ctx, req, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, repo := range page {
+			if codeSecurityConfigurationAttachedStatuses[repo.Status] {
+				ids[repo.Repository.GetID()] = true
+			}
+		}
+
+		if resp.After == "" {
+			break
+		}
+		params.Set("after", resp.After)
+	}
+
+	return ids, nil
+}
+
+// getCodeSecurityConfigurationDefault returns the new repositories the
+// configuration is applied to by default, or "none".
+func getCodeSecurityConfigurationDefault(ctx context.Context, meta interface{}, configurationID int64) (string, error) {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	req, err := client.NewRequest("GET", fmt.Sprintf("orgs/%s/code-security/configurations/defaults", orgName), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var defaults []*codeSecurityConfigurationDefault
+	_, err = client.Do(ctx, req, &defaults)
+	if err != nil {
+		return "", err
+	}
+
+	for _, d := range defaults {
+		if d.Configuration != nil && d.Configuration.ID == configurationID {
+			return d.DefaultForNewRepos, nil
+		}
+	}
+	return "none", nil
+}
+
+func setCodeSecurityConfigurationDefault(ctx context.Context, meta interface{}, configurationID int64, defaultForNewRepos string) error {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	u := fmt.Sprintf("orgs/%s/code-security/configurations/%d/defaults", orgName, configurationID)
+	req, err := client.NewRequest("PUT", u, &codeSecurityConfigurationDefaultRequest{
+		DefaultForNewRepos: defaultForNewRepos,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Setting code security configuration %s/%d as default for %s new repositories", orgName, configurationID, defaultForNewRepos)
+	_, err = client.Do(ctx, req, nil)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("code security configuration %s/%d does not exist", orgName, configurationID)
+		}
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubOrganizationSecurityConfigurationAttachment(t *testing.T) {
+	t.Run("attaches the configuration and sets it as default", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/code-security/configurations/17/attach",
+				ExpectedMethod: "POST",
+				ExpectedBody:   []byte(`{"scope":"selected","selected_repository_ids":[42]}` + "\n"),
+				ResponseBody:   `{}`,
+				StatusCode:     http.StatusAccepted,
+			},
+			{
+				ExpectedUri:    "/orgs/example/code-security/configurations/17/defaults",
+				ExpectedMethod: "PUT",
+				ExpectedBody:   []byte(`{"default_for_new_repos":"public"}` + "\n"),
+				ResponseBody:   `{"default_for_new_repos": "public"}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/code-security/configurations/17/repositories?per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"status": "attaching", "repository": {"id": 42}}]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/example/code-security/configurations/defaults",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"default_for_new_repos": "public", "configuration": {"id": 17}}]`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubOrganizationSecurityConfigurationAttachment().Schema, map[string]interface{}{
+			"configuration_id":        17,
+			"selected_repository_ids": []interface{}{42},
+			"default_for_new_repos":   "public",
+		})
+
+		err := resourceGithubOrganizationSecurityConfigurationAttachmentCreate(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "17" {
+			t.Errorf("Unexpected ID %q", d.Id())
+		}
+		if count := d.Get("selected_repository_ids.#").(int); count != 1 {
+			t.Errorf("Expected 1 repository, got %d", count)
+		}
+		if v := d.Get("default_for_new_repos").(string); v != "public" {
+			t.Errorf("Unexpected default_for_new_repos %q", v)
+		}
+	})
+
+	t.Run("drops repositories the configuration was detached from", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/code-security/configurations/17/repositories?per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"status": "attached", "repository": {"id": 42}}, {"status": "attached", "repository": {"id": 7}}]`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubOrganizationSecurityConfigurationAttachment().Schema, map[string]interface{}{
+			"configuration_id":        17,
+			"selected_repository_ids": []interface{}{42, 43},
+		})
+		d.SetId("17")
+
+		err := resourceGithubOrganizationSecurityConfigurationAttachmentRead(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		ids := d.Get("selected_repository_ids").(*schema.Set)
+		if ids.Len() != 1 || !ids.Contains(42) {
+			t.Errorf("Expected only repository 42, got %v", ids.List())
+		}
+	})
+
+	t.Run("requires repositories for the selected scope", func(t *testing.T) {
+		meta := &Owner{name: "example", v3client: github.NewClient(nil), IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubOrganizationSecurityConfigurationAttachment().Schema, map[string]interface{}{
+			"configuration_id": 17,
+		})
+
+		err := resourceGithubOrganizationSecurityConfigurationAttachmentCreate(d, meta)
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+	})
+}
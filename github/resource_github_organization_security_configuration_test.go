@@ -0,0 +1,88 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubOrganizationSecurityConfiguration(t *testing.T) {
+	t.Run("creates a configuration with only the configured features", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/code-security/configurations",
+				ExpectedMethod: "POST",
+				ExpectedBody:   []byte(`{"name":"high-risk","description":"High risk repositories","secret_scanning":"enabled","enforcement":"enforced"}` + "\n"),
+				ResponseBody:   `{"id": 17}`,
+				StatusCode:     http.StatusCreated,
+			},
+			{
+				ExpectedUri:    "/orgs/example/code-security/configurations/17",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"id": 17, "name": "high-risk", "description": "High risk repositories", "target_type": "organization", "secret_scanning": "enabled", "dependabot_alerts": "not_set", "enforcement": "enforced"}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubOrganizationSecurityConfiguration().Schema, map[string]interface{}{
+			"name":            "high-risk",
+			"description":     "High risk repositories",
+			"secret_scanning": "enabled",
+		})
+
+		err := resourceGithubOrganizationSecurityConfigurationCreate(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "17" {
+			t.Errorf("Unexpected ID %q", d.Id())
+		}
+		if v := d.Get("dependabot_alerts").(string); v != "not_set" {
+			t.Errorf("Unexpected dependabot_alerts %q", v)
+		}
+		if v := d.Get("target_type").(string); v != "organization" {
+			t.Errorf("Unexpected target_type %q", v)
+		}
+	})
+
+	t.Run("removes a deleted configuration from state", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/example/code-security/configurations/17",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"message": "Not Found"}`,
+				StatusCode:     http.StatusNotFound,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubOrganizationSecurityConfiguration().Schema, map[string]interface{}{})
+		d.SetId("17")
+
+		err := resourceGithubOrganizationSecurityConfigurationRead(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "" {
+			t.Errorf("Expected the configuration to be removed from state, got ID %q", d.Id())
+		}
+	})
+}
@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"log"
+	"sort"
 	"strconv"
 
 	"github.com/google/go-github/v65/github"
@@ -168,10 +169,70 @@ func resourceGithubOrganizationSettings() *schema.Resource {
 				Default:     false,
 				Description: "Whether or not secret scanning push protection is enabled for new repositories.",
 			},
+			"ignore_fields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(organizationSettingsFieldNames(), false),
+				},
+				Description: "Settings that are neither updated nor read back, e.g. because they are enforced by an enterprise policy.",
+			},
 		},
 	}
 }
 
+// organizationSettingsFields maps each setting to a function clearing it from
+// an update request, allowing it to be left untouched via ignore_fields.
+var organizationSettingsFields = map[string]func(*github.Organization){
+	"billing_email":                   func(o *github.Organization) { o.BillingEmail = nil },
+	"company":                         func(o *github.Organization) { o.Company = nil },
+	"email":                           func(o *github.Organization) { o.Email = nil },
+	"twitter_username":                func(o *github.Organization) { o.TwitterUsername = nil },
+	"location":                        func(o *github.Organization) { o.Location = nil },
+	"name":                            func(o *github.Organization) { o.Name = nil },
+	"description":                     func(o *github.Organization) { o.Description = nil },
+	"has_organization_projects":       func(o *github.Organization) { o.HasOrganizationProjects = nil },
+	"has_repository_projects":         func(o *github.Organization) { o.HasRepositoryProjects = nil },
+	"default_repository_permission":   func(o *github.Organization) { o.DefaultRepoPermission = nil },
+	"members_can_create_repositories": func(o *github.Organization) { o.MembersCanCreateRepos = nil },
+	"members_can_create_internal_repositories":                     func(o *github.Organization) { o.MembersCanCreateInternalRepos = nil },
+	"members_can_create_private_repositories":                      func(o *github.Organization) { o.MembersCanCreatePrivateRepos = nil },
+	"members_can_create_public_repositories":                       func(o *github.Organization) { o.MembersCanCreatePublicRepos = nil },
+	"members_can_create_pages":                                     func(o *github.Organization) { o.MembersCanCreatePages = nil },
+	"members_can_create_public_pages":                              func(o *github.Organization) { o.MembersCanCreatePublicPages = nil },
+	"members_can_create_private_pages":                             func(o *github.Organization) { o.MembersCanCreatePrivatePages = nil },
+	"members_can_fork_private_repositories":                        func(o *github.Organization) { o.MembersCanForkPrivateRepos = nil },
+	"web_commit_signoff_required":                                  func(o *github.Organization) { o.WebCommitSignoffRequired = nil },
+	"blog":                                                         func(o *github.Organization) { o.Blog = nil },
+	"advanced_security_enabled_for_new_repositories":               func(o *github.Organization) { o.AdvancedSecurityEnabledForNewRepos = nil },
+	"dependabot_alerts_enabled_for_new_repositories":               func(o *github.Organization) { o.DependabotAlertsEnabledForNewRepos = nil },
+	"dependabot_security_updates_enabled_for_new_repositories":     func(o *github.Organization) { o.DependabotSecurityUpdatesEnabledForNewRepos = nil },
+	"dependency_graph_enabled_for_new_repositories":                func(o *github.Organization) { o.DependencyGraphEnabledForNewRepos = nil },
+	"secret_scanning_enabled_for_new_repositories":                 func(o *github.Organization) { o.SecretScanningEnabledForNewRepos = nil },
+	"secret_scanning_push_protection_enabled_for_new_repositories": func(o *github.Organization) { o.SecretScanningPushProtectionEnabledForNewRepos = nil },
+}
+
+func organizationSettingsFieldNames() []string {
+	names := make([]string, 0, len(organizationSettingsFields))
+	for name := range organizationSettingsFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// omitIgnoredOrganizationSettings clears the ignored settings from an update
+// request so that they aren't sent to GitHub.
+func omitIgnoredOrganizationSettings(settings *github.Organization, ignored *schema.Set) {
+	for _, name := range ignored.List() {
+		if omit, ok := organizationSettingsFields[name.(string)]; ok {
+			omit(settings)
+		}
+	}
+}
+
 func resourceGithubOrganizationSettingsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	err := checkOrganization(meta)
 	if err != nil {
@@ -266,6 +327,11 @@ func resourceGithubOrganizationSettingsCreateOrUpdate(d *schema.ResourceData, me
 		SecretScanningPushProtectionEnabledForNewRepos: github.Bool(d.Get("secret_scanning_push_protection_enabled_for_new_repositories").(bool)),
 	}
 
+	ignored := d.Get("ignore_fields").(*schema.Set)
+	omitIgnoredOrganizationSettings(&settings, ignored)
+	omitIgnoredOrganizationSettings(&enterpriseSettings, ignored)
+	omitIgnoredOrganizationSettings(&enterpriseSettingsNoFork, ignored)
+
 	orgPlan, _, err := client.Organizations.Edit(ctx, org, nil)
 	if err != nil {
 		return err
@@ -313,82 +379,92 @@ func resourceGithubOrganizationSettingsRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	if err = d.Set("billing_email", orgSettings.GetBillingEmail()); err != nil {
+	// Ignored settings keep their configured value instead of the one
+	// enforced on GitHub, so that they don't produce a diff.
+	ignored := d.Get("ignore_fields").(*schema.Set)
+	set := func(key string, value interface{}) error {
+		if ignored.Contains(key) {
+			return nil
+		}
+		return d.Set(key, value)
+	}
+
+	if err = set("billing_email", orgSettings.GetBillingEmail()); err != nil {
 		return err
 	}
-	if err = d.Set("company", orgSettings.GetCompany()); err != nil {
+	if err = set("company", orgSettings.GetCompany()); err != nil {
 		return err
 	}
-	if err = d.Set("email", orgSettings.GetEmail()); err != nil {
+	if err = set("email", orgSettings.GetEmail()); err != nil {
 		return err
 	}
-	if err = d.Set("twitter_username", orgSettings.GetTwitterUsername()); err != nil {
+	if err = set("twitter_username", orgSettings.GetTwitterUsername()); err != nil {
 		return err
 	}
-	if err = d.Set("location", orgSettings.GetLocation()); err != nil {
+	if err = set("location", orgSettings.GetLocation()); err != nil {
 		return err
 	}
-	if err = d.Set("name", orgSettings.GetName()); err != nil {
+	if err = set("name", orgSettings.GetName()); err != nil {
 		return err
 	}
-	if err = d.Set("description", orgSettings.GetDescription()); err != nil {
+	if err = set("description", orgSettings.GetDescription()); err != nil {
 		return err
 	}
-	if err = d.Set("has_organization_projects", orgSettings.GetHasOrganizationProjects()); err != nil {
+	if err = set("has_organization_projects", orgSettings.GetHasOrganizationProjects()); err != nil {
 		return err
 	}
-	if err = d.Set("has_repository_projects", orgSettings.GetHasRepositoryProjects()); err != nil {
+	if err = set("has_repository_projects", orgSettings.GetHasRepositoryProjects()); err != nil {
 		return err
 	}
-	if err = d.Set("default_repository_permission", orgSettings.GetDefaultRepoPermission()); err != nil {
+	if err = set("default_repository_permission", orgSettings.GetDefaultRepoPermission()); err != nil {
 		return err
 	}
-	if err = d.Set("members_can_create_repositories", orgSettings.GetMembersCanCreateRepos()); err != nil {
+	if err = set("members_can_create_repositories", orgSettings.GetMembersCanCreateRepos()); err != nil {
 		return err
 	}
-	if err = d.Set("members_can_create_internal_repositories", orgSettings.GetMembersCanCreateInternalRepos()); err != nil {
+	if err = set("members_can_create_internal_repositories", orgSettings.GetMembersCanCreateInternalRepos()); err != nil {
 		return err
 	}
-	if err = d.Set("members_can_create_private_repositories", orgSettings.GetMembersCanCreatePrivateRepos()); err != nil {
+	if err = set("members_can_create_private_repositories", orgSettings.GetMembersCanCreatePrivateRepos()); err != nil {
 		return err
 	}
-	if err = d.Set("members_can_create_public_repositories", orgSettings.GetMembersCanCreatePublicRepos()); err != nil {
+	if err = set("members_can_create_public_repositories", orgSettings.GetMembersCanCreatePublicRepos()); err != nil {
 		return err
 	}
-	if err = d.Set("members_can_create_pages", orgSettings.GetMembersCanCreatePages()); err != nil {
+	if err = set("members_can_create_pages", orgSettings.GetMembersCanCreatePages()); err != nil {
 		return err
 	}
-	if err = d.Set("members_can_create_public_pages", orgSettings.GetMembersCanCreatePublicPages()); err != nil {
+	if err = set("members_can_create_public_pages", orgSettings.GetMembersCanCreatePublicPages()); err != nil {
 		return err
 	}
-	if err = d.Set("members_can_create_private_pages", orgSettings.GetMembersCanCreatePrivatePages()); err != nil {
+	if err = set("members_can_create_private_pages", orgSettings.GetMembersCanCreatePrivatePages()); err != nil {
 		return err
 	}
-	if err = d.Set("members_can_fork_private_repositories", orgSettings.GetMembersCanForkPrivateRepos()); err != nil {
+	if err = set("members_can_fork_private_repositories", orgSettings.GetMembersCanForkPrivateRepos()); err != nil {
 		return err
 	}
-	if err = d.Set("web_commit_signoff_required", orgSettings.GetWebCommitSignoffRequired()); err != nil {
+	if err = set("web_commit_signoff_required", orgSettings.GetWebCommitSignoffRequired()); err != nil {
 		return err
 	}
-	if err = d.Set("blog", orgSettings.GetBlog()); err != nil {
+	if err = set("blog", orgSettings.GetBlog()); err != nil {
 		return err
 	}
-	if err = d.Set("advanced_security_enabled_for_new_repositories", orgSettings.GetAdvancedSecurityEnabledForNewRepos()); err != nil {
+	if err = set("advanced_security_enabled_for_new_repositories", orgSettings.GetAdvancedSecurityEnabledForNewRepos()); err != nil {
 		return err
 	}
-	if err = d.Set("dependabot_alerts_enabled_for_new_repositories", orgSettings.GetDependabotAlertsEnabledForNewRepos()); err != nil {
+	if err = set("dependabot_alerts_enabled_for_new_repositories", orgSettings.GetDependabotAlertsEnabledForNewRepos()); err != nil {
 		return err
 	}
-	if err = d.Set("dependabot_security_updates_enabled_for_new_repositories", orgSettings.GetDependabotSecurityUpdatesEnabledForNewRepos()); err != nil {
+	if err = set("dependabot_security_updates_enabled_for_new_repositories", orgSettings.GetDependabotSecurityUpdatesEnabledForNewRepos()); err != nil {
 		return err
 	}
-	if err = d.Set("dependency_graph_enabled_for_new_repositories", orgSettings.GetDependencyGraphEnabledForNewRepos()); err != nil {
+	if err = set("dependency_graph_enabled_for_new_repositories", orgSettings.GetDependencyGraphEnabledForNewRepos()); err != nil {
 		return err
 	}
-	if err = d.Set("secret_scanning_enabled_for_new_repositories", orgSettings.GetSecretScanningEnabledForNewRepos()); err != nil {
+	if err = set("secret_scanning_enabled_for_new_repositories", orgSettings.GetSecretScanningEnabledForNewRepos()); err != nil {
 		return err
 	}
-	if err = d.Set("secret_scanning_push_protection_enabled_for_new_repositories", orgSettings.GetSecretScanningPushProtectionEnabledForNewRepos()); err != nil {
+	if err = set("secret_scanning_push_protection_enabled_for_new_repositories", orgSettings.GetSecretScanningPushProtectionEnabledForNewRepos()); err != nil {
 		return err
 	}
 	return nil
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccGithubOrganizationSettings(t *testing.T) {
@@ -181,3 +183,27 @@ func TestAccGithubOrganizationSettings(t *testing.T) {
 		})
 	})
 }
+
+func TestOmitIgnoredOrganizationSettings(t *testing.T) {
+	settings := github.Organization{
+		BillingEmail:               github.String("test@example.com"),
+		MembersCanCreateRepos:      github.Bool(false),
+		MembersCanForkPrivateRepos: github.Bool(true),
+	}
+
+	ignored := schema.NewSet(schema.HashString, []interface{}{
+		"members_can_create_repositories",
+		"members_can_fork_private_repositories",
+	})
+	omitIgnoredOrganizationSettings(&settings, ignored)
+
+	if settings.MembersCanCreateRepos != nil {
+		t.Errorf("Expected members_can_create_repositories to be omitted, got %v", settings.GetMembersCanCreateRepos())
+	}
+	if settings.MembersCanForkPrivateRepos != nil {
+		t.Errorf("Expected members_can_fork_private_repositories to be omitted, got %v", settings.GetMembersCanForkPrivateRepos())
+	}
+	if settings.GetBillingEmail() != "test@example.com" {
+		t.Errorf("Expected billing_email to be kept, got %v", settings.GetBillingEmail())
+	}
+}
@@ -188,6 +188,13 @@ func resourceGithubOrganizationWebhookUpdate(d *schema.ResourceData, meta interf
 	}
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
+	// Leave the configuration alone unless it changed, so that one managed by
+	// github_organization_webhook_configuration, with the configuration block
+	// ignored here, isn't overwritten by stale values from state.
+	if !d.HasChange("configuration") {
+		webhookObj.Config = nil
+	}
+
 	_, _, err = client.Organizations.EditHook(ctx,
 		orgName, hookID, webhookObj)
 	if err != nil {
@@ -0,0 +1,150 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubOrganizationWebhookConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationWebhookConfigurationCreateOrUpdate,
+		Read:   resourceGithubOrganizationWebhookConfigurationRead,
+		Update: resourceGithubOrganizationWebhookConfigurationCreateOrUpdate,
+		Delete: resourceGithubOrganizationWebhookConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"webhook_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the organization webhook.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The URL to which the payloads will be delivered.",
+			},
+			"content_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "form",
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"form", "json"}, false), "content_type"),
+				Description:      "The media type used to serialize the payloads. Can be one of 'form' or 'json'.",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The secret used to sign the payloads.",
+			},
+			"insecure_ssl": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the SSL certificate of the host of 'url' is not verified when delivering payloads.",
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationWebhookConfigurationCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	hookID := int64(d.Get("webhook_id").(int))
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	config := webhookConfigFromInterface(map[string]interface{}{
+		"url":          d.Get("url").(string),
+		"content_type": d.Get("content_type").(string),
+		"insecure_ssl": d.Get("insecure_ssl").(bool),
+		"secret":       d.Get("secret").(string),
+	})
+
+	log.Printf("[DEBUG] Updating configuration of organization webhook %s/%d", orgName, hookID)
+	_, _, err = client.Organizations.EditHookConfiguration(ctx, orgName, hookID, config)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(hookID, 10))
+
+	return resourceGithubOrganizationWebhookConfigurationRead(d, meta)
+}
+
+func resourceGithubOrganizationWebhookConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	hookID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	config, _, err := client.Organizations.GetHookConfiguration(ctx, orgName, hookID)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing configuration of organization webhook %s/%s from state because the webhook no longer exists in GitHub",
+					orgName, d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	if err = d.Set("webhook_id", hookID); err != nil {
+		return err
+	}
+	if err = d.Set("url", config.GetURL()); err != nil {
+		return err
+	}
+	if err = d.Set("content_type", config.GetContentType()); err != nil {
+		return err
+	}
+	if err = d.Set("insecure_ssl", config.GetInsecureSSL() == "1"); err != nil {
+		return err
+	}
+
+	// GitHub masks the secret, so only track whether one is set. A secret
+	// added outside of Terraform can't be compared and is left in place.
+	if config.Secret == nil {
+		if err = d.Set("secret", ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationWebhookConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	// A webhook can't exist without a configuration, so the configuration is
+	// left as it is and removed from state only; deleting the webhook itself
+	// is up to github_organization_webhook.
+	log.Printf("[INFO] Removing configuration of organization webhook %s/%s from state, the webhook is left unchanged",
+		meta.(*Owner).name, d.Id())
+	return nil
+}
@@ -0,0 +1,78 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationWebhookConfiguration(t *testing.T) {
+
+	t.Run("manages the configuration separately from the webhook", func(t *testing.T) {
+
+		config := `
+			resource "github_organization_webhook" "test" {
+			  configuration {
+			    url = "https://google.de/webhook"
+			  }
+
+			  events = ["%s"]
+
+			  lifecycle {
+			    ignore_changes = [configuration]
+			  }
+			}
+
+			resource "github_organization_webhook_configuration" "test" {
+			  webhook_id   = github_organization_webhook.test.id
+			  url          = "https://google.de/webhook"
+			  content_type = "json"
+			  secret       = "%s"
+			}
+		`
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, "pull_request", "first"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_organization_webhook_configuration.test", "content_type", "json"),
+							resource.TestCheckResourceAttr("github_organization_webhook.test", "configuration.0.content_type", "json"),
+						),
+					},
+					{
+						// Rotating the secret and changing the events of the
+						// webhook must not revert the configuration.
+						Config: fmt.Sprintf(config, "push", "second"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_organization_webhook_configuration.test", "secret", "second"),
+							resource.TestCheckResourceAttr("github_organization_webhook.test", "configuration.0.content_type", "json"),
+						),
+					},
+					{
+						ResourceName:            "github_organization_webhook_configuration.test",
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: []string{"secret"},
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
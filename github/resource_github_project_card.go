@@ -18,6 +18,9 @@ func resourceGithubProjectCard() *schema.Resource {
 		Read:   resourceGithubProjectCardRead,
 		Update: resourceGithubProjectCardUpdate,
 		Delete: resourceGithubProjectCardDelete,
+
+		DeprecationMessage: classicProjectDeprecationMessage,
+
 		Importer: &schema.ResourceImporter{
 			State: resourceGithubProjectCardImport,
 		},
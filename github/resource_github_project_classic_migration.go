@@ -0,0 +1,407 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+// classicProjectDeprecationMessage is the deprecation message of the resources
+// managing classic projects.
+const classicProjectDeprecationMessage = "Classic projects are being sunset by GitHub. Migrate to Projects (v2), " +
+	"e.g. with github_project_classic_migration, before the classic projects API is removed; " +
+	"this resource will be removed in a future major version."
+
+func resourceGithubProjectClassicMigration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubProjectClassicMigrationCreate,
+		Read:   resourceGithubProjectClassicMigrationRead,
+		Delete: resourceGithubProjectClassicMigrationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"classic_project_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the classic project to migrate.",
+			},
+			"title": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The title of the new project. Defaults to the name of the classic project.",
+			},
+			"status_field_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Column",
+				ValidateFunc: validateProjectStatusFieldName,
+				Description:  "The name of the single select field holding the column of every item. It can't be `Status`, which new projects already have.",
+			},
+			"number": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the new project.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the new project.",
+			},
+			"status_field_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The node ID of the field holding the column of every item.",
+			},
+			"item_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of cards migrated as items.",
+			},
+		},
+	}
+}
+
+// ProjectV2CustomFieldType, ProjectV2SingleSelectFieldOptionColor,
+// ProjectV2SingleSelectFieldOptionInput and CreateProjectV2FieldInput are
+// missing from the vendored githubv4. Their names must match the GraphQL
+// schema, as they are used as the types of the mutation variables.
+type ProjectV2CustomFieldType string
+
+type ProjectV2SingleSelectFieldOptionColor string
+
+type ProjectV2SingleSelectFieldOptionInput struct {
+	Name        githubv4.String                       `json:"name"`
+	Color       ProjectV2SingleSelectFieldOptionColor `json:"color"`
+	Description githubv4.String                       `json:"description"`
+}
+
+type CreateProjectV2FieldInput struct {
+	ProjectID           githubv4.ID                             `json:"projectId"`
+	DataType            ProjectV2CustomFieldType                `json:"dataType"`
+	Name                githubv4.String                         `json:"name"`
+	SingleSelectOptions []ProjectV2SingleSelectFieldOptionInput `json:"singleSelectOptions,omitempty"`
+}
+
+type classicProjectColumn struct {
+	name  githubv4.String
+	cards []classicProjectCard
+}
+
+type classicProjectCard struct {
+	Note    githubv4.String
+	Content struct {
+		Issue struct {
+			ID githubv4.ID
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			ID githubv4.ID
+		} `graphql:"... on PullRequest"`
+	}
+}
+
+// contentID returns the node ID of the issue or pull request of the card, or
+// nil if the card is a note.
+func (c classicProjectCard) contentID() githubv4.ID {
+	if c.Content.Issue.ID != nil {
+		return c.Content.Issue.ID
+	}
+	return c.Content.PullRequest.ID
+}
+
+func resourceGithubProjectClassicMigrationCreate(d *schema.ResourceData, meta interface{}) error {
+	v3 := meta.(*Owner).v3client
+	v4 := meta.(*Owner).v4client
+	ctx := context.Background()
+
+	classicID := int64(d.Get("classic_project_id").(int))
+	classic, _, err := v3.Projects.GetProject(ctx, classicID)
+	if err != nil {
+		return err
+	}
+
+	var query struct {
+		Node struct {
+			Project struct {
+				Owner struct {
+					Typename   githubv4.String `graphql:"__typename"`
+					ID         githubv4.ID
+					Repository struct {
+						Owner struct {
+							ID githubv4.ID
+						}
+					} `graphql:"... on Repository"`
+				}
+				Columns struct {
+					Nodes []struct {
+						ID   githubv4.ID
+						Name githubv4.String
+					}
+					PageInfo PageInfo
+				} `graphql:"columns(first: 100, after: $cursor)"`
+			} `graphql:"... on Project"`
+		} `graphql:"node(id: $id)"`
+	}
+	variables := map[string]interface{}{
+		"id":     githubv4.ID(classic.GetNodeID()),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	var columns []classicProjectColumn
+	itemCount := 0
+	for {
+		err = v4.Query(ctx, &query, variables)
+		if err != nil {
+			return err
+		}
+		for _, node := range query.Node.Project.Columns.Nodes {
+			cards, err := getClassicProjectCards(ctx, v4, node.ID)
+			if err != nil {
+				return err
+			}
+			columns = append(columns, classicProjectColumn{name: node.Name, cards: cards})
+			itemCount += len(cards)
+		}
+		if !query.Node.Project.Columns.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Node.Project.Columns.PageInfo.EndCursor)
+	}
+
+	title := d.Get("title").(string)
+	if title == "" {
+		title = classic.GetName()
+	}
+	input := githubv4.CreateProjectV2Input{
+		OwnerID: query.Node.Project.Owner.ID,
+		Title:   githubv4.String(title),
+	}
+	if query.Node.Project.Owner.Typename == "Repository" {
+		// Projects (v2) belong to users and organizations, and can only be
+		// linked to repositories.
+		repositoryID := query.Node.Project.Owner.ID
+		input.OwnerID = query.Node.Project.Owner.Repository.Owner.ID
+		input.RepositoryID = &repositoryID
+	}
+
+	var createProject struct {
+		CreateProjectV2 struct {
+			ProjectV2 struct {
+				ID githubv4.ID
+			}
+		} `graphql:"createProjectV2(input: $input)"`
+	}
+	err = v4.Mutate(ctx, &createProject, input, nil)
+	if err != nil {
+		return err
+	}
+	projectID := createProject.CreateProjectV2.ProjectV2.ID
+	d.SetId(projectID.(string))
+	log.Printf("[INFO] Migrating %d cards of classic project %d to project %s", itemCount, classicID, d.Id())
+
+	var createField struct {
+		CreateProjectV2Field struct {
+			ProjectV2Field struct {
+				SingleSelectField struct {
+					ID      githubv4.ID
+					Options []struct {
+						ID   githubv4.String
+						Name githubv4.String
+					}
+				} `graphql:"... on ProjectV2SingleSelectField"`
+			}
+		} `graphql:"createProjectV2Field(input: $input)"`
+	}
+	fieldInput := CreateProjectV2FieldInput{
+		ProjectID: projectID,
+		DataType:  ProjectV2CustomFieldType("SINGLE_SELECT"),
+		Name:      githubv4.String(d.Get("status_field_name").(string)),
+	}
+	for _, column := range columns {
+		fieldInput.SingleSelectOptions = append(fieldInput.SingleSelectOptions, ProjectV2SingleSelectFieldOptionInput{
+			Name:  column.name,
+			Color: ProjectV2SingleSelectFieldOptionColor("GRAY"),
+		})
+	}
+	err = v4.Mutate(ctx, &createField, fieldInput, nil)
+	if err != nil {
+		return err
+	}
+	field := createField.CreateProjectV2Field.ProjectV2Field.SingleSelectField
+
+	// Column names are unique within a classic project, so the options are
+	// matched to their column by name.
+	optionIDs := make(map[githubv4.String]githubv4.String)
+	for _, option := range field.Options {
+		optionIDs[option.Name] = option.ID
+	}
+
+	for _, column := range columns {
+		for _, card := range column.cards {
+			itemID, err := addClassicProjectCard(ctx, v4, projectID, card)
+			if err != nil {
+				return err
+			}
+
+			var updateItem struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+			}
+			optionID := optionIDs[column.name]
+			err = v4.Mutate(ctx, &updateItem, githubv4.UpdateProjectV2ItemFieldValueInput{
+				ProjectID: projectID,
+				ItemID:    itemID,
+				FieldID:   field.ID,
+				Value:     githubv4.ProjectV2FieldValue{SingleSelectOptionID: &optionID},
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = d.Set("status_field_id", field.ID); err != nil {
+		return err
+	}
+	if err = d.Set("item_count", itemCount); err != nil {
+		return err
+	}
+
+	return resourceGithubProjectClassicMigrationRead(d, meta)
+}
+
+// getClassicProjectCards returns the cards of the classic project column that
+// are not archived.
+func getClassicProjectCards(ctx context.Context, v4 *githubv4.Client, columnID githubv4.ID) ([]classicProjectCard, error) {
+	var query struct {
+		Node struct {
+			ProjectColumn struct {
+				Cards struct {
+					Nodes    []classicProjectCard
+					PageInfo PageInfo
+				} `graphql:"cards(first: 100, after: $cursor, archivedStates: [NOT_ARCHIVED])"`
+			} `graphql:"... on ProjectColumn"`
+		} `graphql:"node(id: $id)"`
+	}
+	variables := map[string]interface{}{
+		"id":     columnID,
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	var cards []classicProjectCard
+	for {
+		err := v4.Query(ctx, &query, variables)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, query.Node.ProjectColumn.Cards.Nodes...)
+		if !query.Node.ProjectColumn.Cards.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Node.ProjectColumn.Cards.PageInfo.EndCursor)
+	}
+	return cards, nil
+}
+
+// addClassicProjectCard adds the issue or pull request of the card to the
+// project, or a draft issue if the card is a note, and returns the new item.
+func addClassicProjectCard(ctx context.Context, v4 *githubv4.Client, projectID githubv4.ID, card classicProjectCard) (githubv4.ID, error) {
+	if contentID := card.contentID(); contentID != nil {
+		var mutate struct {
+			AddProjectV2ItemById struct {
+				Item struct {
+					ID githubv4.ID
+				}
+			} `graphql:"addProjectV2ItemById(input: $input)"`
+		}
+		err := v4.Mutate(ctx, &mutate, githubv4.AddProjectV2ItemByIdInput{
+			ProjectID: projectID,
+			ContentID: contentID,
+		}, nil)
+		return mutate.AddProjectV2ItemById.Item.ID, err
+	}
+
+	// The first line of the note becomes the title of the draft issue.
+	title, body, _ := strings.Cut(strings.TrimSpace(string(card.Note)), "\n")
+	input := githubv4.AddProjectV2DraftIssueInput{
+		ProjectID: projectID,
+		Title:     githubv4.String(strings.TrimSpace(title)),
+	}
+	if body = strings.TrimSpace(body); body != "" {
+		input.Body = githubv4.NewString(githubv4.String(body))
+	}
+
+	var mutate struct {
+		AddProjectV2DraftIssue struct {
+			ProjectItem struct {
+				ID githubv4.ID
+			}
+		} `graphql:"addProjectV2DraftIssue(input: $input)"`
+	}
+	err := v4.Mutate(ctx, &mutate, input, nil)
+	return mutate.AddProjectV2DraftIssue.ProjectItem.ID, err
+}
+
+func resourceGithubProjectClassicMigrationRead(d *schema.ResourceData, meta interface{}) error {
+	v4 := meta.(*Owner).v4client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	var query struct {
+		Node struct {
+			ProjectV2 struct {
+				ID     githubv4.ID
+				Title  githubv4.String
+				Number githubv4.Int
+				URL    githubv4.URI `graphql:"url"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $id)"`
+	}
+	err := v4.Query(ctx, &query, map[string]interface{}{"id": githubv4.ID(d.Id())})
+	if err != nil {
+		if strings.Contains(err.Error(), "Could not resolve to a node") {
+			log.Printf("[INFO] Removing project %s from state because it no longer exists in GitHub", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if query.Node.ProjectV2.ID == nil {
+		log.Printf("[INFO] Removing project %s from state because it no longer exists in GitHub", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("title", string(query.Node.ProjectV2.Title)); err != nil {
+		return err
+	}
+	if err = d.Set("number", int(query.Node.ProjectV2.Number)); err != nil {
+		return err
+	}
+	return d.Set("url", query.Node.ProjectV2.URL.String())
+}
+
+func resourceGithubProjectClassicMigrationDelete(d *schema.ResourceData, meta interface{}) error {
+	// The migrated project holds the items moved over from the classic
+	// project, so it is left in place rather than deleted with the resource.
+	log.Printf("[INFO] Removing project %s from state without deleting it; classic project %d is left as is",
+		d.Id(), d.Get("classic_project_id").(int))
+	return nil
+}
+
+// validateProjectStatusFieldName is used as the ValidateFunc of
+// status_field_name, as new projects already have a Status field.
+func validateProjectStatusFieldName(v interface{}, k string) ([]string, []error) {
+	if strings.EqualFold(v.(string), "Status") {
+		return nil, []error{fmt.Errorf("%q can't be Status, which new projects already have", k)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,88 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestResourceGithubProjectClassicMigrationCreate(t *testing.T) {
+	responses := []string{
+		`{"id": 1, "node_id": "PRO_1", "name": "Roadmap"}`,
+		`{"data": {"node": {"owner": {"__typename": "Repository", "id": "R_1", "owner": {"id": "O_1"}}, "columns": {"nodes": [{"id": "COL_1", "name": "To do"}, {"id": "COL_2", "name": "Done"}], "pageInfo": {"endCursor": "", "hasNextPage": false}}}}}`,
+		`{"data": {"node": {"cards": {"nodes": [{"note": null, "content": {"id": "I_1"}}, {"note": "Write docs\nfor the migration", "content": null}], "pageInfo": {"endCursor": "", "hasNextPage": false}}}}}`,
+		`{"data": {"node": {"cards": {"nodes": [], "pageInfo": {"endCursor": "", "hasNextPage": false}}}}}`,
+		`{"data": {"createProjectV2": {"projectV2": {"id": "PVT_1"}}}}`,
+		`{"data": {"createProjectV2Field": {"projectV2Field": {"id": "FLD_1", "options": [{"id": "OPT_1", "name": "To do"}, {"id": "OPT_2", "name": "Done"}]}}}}`,
+		`{"data": {"addProjectV2ItemById": {"item": {"id": "ITEM_1"}}}}`,
+		`{"data": {"updateProjectV2ItemFieldValue": {"projectV2Item": {"id": "ITEM_1"}}}}`,
+		`{"data": {"addProjectV2DraftIssue": {"projectItem": {"id": "ITEM_2"}}}}`,
+		`{"data": {"updateProjectV2ItemFieldValue": {"projectV2Item": {"id": "ITEM_2"}}}}`,
+		`{"data": {"node": {"id": "PVT_1", "title": "Roadmap", "number": 3, "url": "https://github.com/orgs/example/projects/3"}}}`,
+	}
+
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.URL.Path+" "+string(body))
+		if len(requests) > len(responses) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprint(w, responses[len(requests)-1])
+	}))
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+	meta := &Owner{name: "example", v3client: client, v4client: githubv4.NewEnterpriseClient(ts.URL+"/graphql", nil)}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubProjectClassicMigration().Schema, map[string]interface{}{
+		"classic_project_id": 1,
+	})
+	err := resourceGithubProjectClassicMigrationCreate(d, meta)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	if len(requests) != len(responses) {
+		t.Fatalf("Expected %d requests, got %d:\n%s", len(responses), len(requests), strings.Join(requests, "\n"))
+	}
+	expectRequest := func(i int, substrings ...string) {
+		for _, s := range substrings {
+			if !strings.Contains(requests[i], s) {
+				t.Errorf("Expected request %d to contain %s, got %s", i, s, requests[i])
+			}
+		}
+	}
+	expectRequest(0, "/projects/1")
+	expectRequest(4, `"ownerId":"O_1"`, `"repositoryId":"R_1"`, `"title":"Roadmap"`)
+	expectRequest(5, `"dataType":"SINGLE_SELECT"`, `"name":"Column"`, `{"name":"To do","color":"GRAY","description":""}`, `{"name":"Done","color":"GRAY","description":""}`)
+	expectRequest(6, `"contentId":"I_1"`)
+	expectRequest(7, `"itemId":"ITEM_1"`, `"fieldId":"FLD_1"`, `"singleSelectOptionId":"OPT_1"`)
+	expectRequest(8, `"title":"Write docs"`, `"body":"for the migration"`)
+	expectRequest(9, `"itemId":"ITEM_2"`, `"singleSelectOptionId":"OPT_1"`)
+
+	if d.Id() != "PVT_1" {
+		t.Errorf("Expected ID PVT_1, got %q", d.Id())
+	}
+	if n := d.Get("item_count").(int); n != 2 {
+		t.Errorf("Expected 2 items, got %d", n)
+	}
+	if n := d.Get("number").(int); n != 3 {
+		t.Errorf("Expected number 3, got %d", n)
+	}
+	if title := d.Get("title").(string); title != "Roadmap" {
+		t.Errorf("Expected title Roadmap, got %q", title)
+	}
+}
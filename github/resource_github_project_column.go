@@ -17,6 +17,9 @@ func resourceGithubProjectColumn() *schema.Resource {
 		Read:   resourceGithubProjectColumnRead,
 		Update: resourceGithubProjectColumnUpdate,
 		Delete: resourceGithubProjectColumnDelete,
+
+		DeprecationMessage: classicProjectDeprecationMessage,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
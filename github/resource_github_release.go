@@ -1,11 +1,18 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +28,8 @@ func resourceGithubRelease() *schema.Resource {
 			State: resourceGithubReleaseImport,
 		},
 
+		CustomizeDiff: resourceGithubReleaseCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"repository": {
 				Type:        schema.TypeString,
@@ -78,6 +87,47 @@ func resourceGithubRelease() *schema.Resource {
 				ForceNew:    true,
 				Description: "If specified, a discussion of the specified category is created and linked to the release. The value must be a category that already exists in the repository.",
 			},
+			"asset": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A local file to upload as an asset of the release.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The file name of the asset.",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The path of the local file to upload.",
+						},
+						"content_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "application/octet-stream",
+							Description: "The media type of the asset.",
+						},
+					},
+				},
+			},
+			"checksums_file_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If specified, the SHA256 checksums of the assets are uploaded as an asset with this name, e.g. 'SHA256SUMS'.",
+			},
+			"checksums_signature_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"checksums_file_name"},
+				Description:  "The path of a local detached signature of the checksums file to upload as an asset, named after the file.",
+			},
+			"checksums": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA256 checksums of the assets, in the format of 'sha256sum'.",
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -182,10 +232,13 @@ func resourceGithubReleaseCreateUpdate(d *schema.ResourceData, meta interface{})
 			log.Printf("[DEBUG] Response from creating release: %#v", *resp)
 		}
 	} else {
-		number := d.Get("number").(int64)
+		releaseID, err := strconv.ParseInt(d.Id(), 10, 64)
+		if err != nil {
+			return unconvertibleIdErr(d.Id(), err)
+		}
 		log.Printf("[DEBUG] Updating release: %d:%s (%s/%s)",
-			number, targetCommitish, owner, repoName)
-		release, resp, err = client.Repositories.EditRelease(ctx, owner, repoName, number, req)
+			releaseID, targetCommitish, owner, repoName)
+		release, resp, err = client.Repositories.EditRelease(ctx, owner, repoName, releaseID, req)
 		if resp != nil {
 			log.Printf("[DEBUG] Response from updating release: %#v", *resp)
 		}
@@ -195,6 +248,14 @@ func resourceGithubReleaseCreateUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	transformResponseToResourceData(d, release, repoName)
+
+	if d.HasChanges("asset", "checksums_file_name", "checksums_signature_path", "checksums") {
+		err = syncReleaseAssets(ctx, d, meta, release.GetID())
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -223,6 +284,23 @@ func resourceGithubReleaseRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	transformResponseToResourceData(d, release, repository)
+
+	// Clearing the checksums when a managed asset went missing makes the
+	// next plan upload the assets again.
+	managed := releaseManagedAssetNames(d.Get("asset"), d.Get("checksums_file_name").(string), d.Get("checksums_signature_path").(string))
+	if len(managed) > 0 {
+		existing, err := listReleaseAssetIDs(ctx, meta, repository, releaseID)
+		if err != nil {
+			return err
+		}
+		for _, name := range managed {
+			if _, ok := existing[name]; !ok {
+				log.Printf("[INFO] Asset %s of release ID %d for repository %s no longer exists on GitHub", name, releaseID, repository)
+				return d.Set("checksums", "")
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -306,3 +384,192 @@ func transformResponseToResourceData(d *schema.ResourceData, release *github.Rep
 	_ = d.Set("zipball_url", release.GetZipballURL())
 	_ = d.Set("tarball_url", release.GetTarballURL())
 }
+
+type releaseAssetFile struct {
+	name        string
+	path        string
+	contentType string
+}
+
+// expandReleaseAssets returns the assets of the release sorted by name.
+func expandReleaseAssets(v interface{}) []releaseAssetFile {
+	var assets []releaseAssetFile
+	for _, a := range v.(*schema.Set).List() {
+		asset := a.(map[string]interface{})
+		assets = append(assets, releaseAssetFile{
+			name:        asset["name"].(string),
+			path:        asset["path"].(string),
+			contentType: asset["content_type"].(string),
+		})
+	}
+	sort.Slice(assets, func(i, j int) bool { return assets[i].name < assets[j].name })
+	return assets
+}
+
+// releaseManagedAssetNames returns the names of all assets uploaded by the
+// resource, including the checksums file and its signature.
+func releaseManagedAssetNames(assets interface{}, checksumsFileName, signaturePath string) []string {
+	var names []string
+	for _, asset := range expandReleaseAssets(assets) {
+		names = append(names, asset.name)
+	}
+	if checksumsFileName != "" {
+		names = append(names, checksumsFileName)
+	}
+	if signaturePath != "" {
+		names = append(names, filepath.Base(signaturePath))
+	}
+	return names
+}
+
+// releaseAssetChecksums returns the SHA256 checksums of the assets in the
+// format of sha256sum, so a signature created from its output also matches.
+func releaseAssetChecksums(assets []releaseAssetFile) (string, error) {
+	var checksums strings.Builder
+	for _, asset := range assets {
+		content, err := os.ReadFile(asset.path)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&checksums, "%x  %s\n", sha256.Sum256(content), asset.name)
+	}
+	return checksums.String(), nil
+}
+
+// resourceGithubReleaseCustomizeDiff computes the checksums of the assets at
+// plan time, so changes to the contents of the local files are detected.
+func resourceGithubReleaseCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("asset") {
+		return diff.SetNewComputed("checksums")
+	}
+
+	assets := expandReleaseAssets(diff.Get("asset"))
+	for _, asset := range assets {
+		if asset.path == "" {
+			return diff.SetNewComputed("checksums")
+		}
+	}
+
+	names := make(map[string]bool)
+	for _, name := range releaseManagedAssetNames(diff.Get("asset"), diff.Get("checksums_file_name").(string), diff.Get("checksums_signature_path").(string)) {
+		if names[name] {
+			return fmt.Errorf("the release has more than one asset named %q", name)
+		}
+		names[name] = true
+	}
+
+	checksums, err := releaseAssetChecksums(assets)
+	if err != nil {
+		return err
+	}
+	if checksums != diff.Get("checksums").(string) {
+		return diff.SetNew("checksums", checksums)
+	}
+
+	return nil
+}
+
+// syncReleaseAssets replaces the assets previously uploaded by the resource
+// with the current ones. Assets uploaded outside of Terraform are left alone,
+// unless they share a name with a managed asset.
+func syncReleaseAssets(ctx context.Context, d *schema.ResourceData, meta interface{}, releaseID int64) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	oldAssets, newAssets := d.GetChange("asset")
+	oldChecksumsFileName, newChecksumsFileName := d.GetChange("checksums_file_name")
+	oldSignaturePath, newSignaturePath := d.GetChange("checksums_signature_path")
+	oldNames := releaseManagedAssetNames(oldAssets, oldChecksumsFileName.(string), oldSignaturePath.(string))
+	newNames := releaseManagedAssetNames(newAssets, newChecksumsFileName.(string), newSignaturePath.(string))
+
+	existing, err := listReleaseAssetIDs(ctx, meta, repoName, releaseID)
+	if err != nil {
+		return err
+	}
+	for _, name := range append(oldNames, newNames...) {
+		id, ok := existing[name]
+		if !ok {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting asset %s of release ID %d (%s/%s)", name, releaseID, owner, repoName)
+		_, err = client.Repositories.DeleteReleaseAsset(ctx, owner, repoName, id)
+		if err != nil {
+			return err
+		}
+		delete(existing, name)
+	}
+
+	assets := expandReleaseAssets(newAssets)
+	var checksums strings.Builder
+	for _, asset := range assets {
+		content, err := os.ReadFile(asset.path)
+		if err != nil {
+			return err
+		}
+		err = uploadReleaseAsset(ctx, meta, repoName, releaseID, asset.name, asset.contentType, content)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&checksums, "%x  %s\n", sha256.Sum256(content), asset.name)
+	}
+
+	if name := newChecksumsFileName.(string); name != "" {
+		err = uploadReleaseAsset(ctx, meta, repoName, releaseID, name, "text/plain", []byte(checksums.String()))
+		if err != nil {
+			return err
+		}
+	}
+	if path := newSignaturePath.(string); path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		err = uploadReleaseAsset(ctx, meta, repoName, releaseID, filepath.Base(path), "application/octet-stream", content)
+		if err != nil {
+			return err
+		}
+	}
+
+	return d.Set("checksums", checksums.String())
+}
+
+func listReleaseAssetIDs(ctx context.Context, meta interface{}, repoName string, releaseID int64) (map[string]int64, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	options := &github.ListOptions{PerPage: maxPerPage}
+
+	ids := make(map[string]int64)
+	for {
+		assets, resp, err := client.Repositories.ListReleaseAssets(ctx, owner, repoName, releaseID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, asset := range assets {
+			ids[asset.GetName()] = asset.GetID()
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return ids, nil
+}
+
+func uploadReleaseAsset(ctx context.Context, meta interface{}, repoName string, releaseID int64, name, contentType string, content []byte) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	log.Printf("[DEBUG] Uploading asset %s of release ID %d (%s/%s)", name, releaseID, owner, repoName)
+	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets?name=%s", owner, repoName, releaseID, url.QueryEscape(name))
+	req, err := client.NewUploadRequest(u, bytes.NewReader(content), int64(len(content)), contentType)
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
@@ -1,13 +1,20 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccGithubReleaseResource(t *testing.T) {
@@ -206,3 +213,88 @@ func importReleaseByResourcePaths(repoLogicalName, releaseLogicalName string) re
 		return fmt.Sprintf("%s:%s", repoID, releaseID), nil
 	}
 }
+
+func TestSyncReleaseAssets(t *testing.T) {
+	t.Run("uploads the assets along with their checksums and signature", func(t *testing.T) {
+		dir := t.TempDir()
+		for name, content := range map[string]string{"b.zip": "bee", "a.zip": "ay", "SHA256SUMS.sig": "signature"} {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+		checksums := "69d61997a241e97931db9dd1cfcef218041a752485f5f7956b09766287682da3  a.zip\n" +
+			"62cb81b5904a262ffaeed02abef36bfc540b09f964b8b0b636662f77ffce6714  b.zip\n"
+
+		assets := []interface{}{
+			map[string]interface{}{"name": "b.zip", "path": filepath.Join(dir, "b.zip"), "content_type": "application/zip"},
+			map[string]interface{}{"name": "a.zip", "path": filepath.Join(dir, "a.zip"), "content_type": "application/zip"},
+		}
+
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo/releases/1/assets?per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"id": 5, "name": "a.zip"}, {"id": 6, "name": "notes.txt"}]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/releases/assets/5",
+				ExpectedMethod: "DELETE",
+				StatusCode:     http.StatusNoContent,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/releases/1/assets?name=a.zip",
+				ExpectedMethod: "POST",
+				ExpectedBody:   []byte("ay"),
+				ResponseBody:   `{"id": 7, "name": "a.zip"}`,
+				StatusCode:     http.StatusCreated,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/releases/1/assets?name=b.zip",
+				ExpectedMethod: "POST",
+				ExpectedBody:   []byte("bee"),
+				ResponseBody:   `{"id": 8, "name": "b.zip"}`,
+				StatusCode:     http.StatusCreated,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/releases/1/assets?name=SHA256SUMS",
+				ExpectedMethod: "POST",
+				ExpectedBody:   []byte(checksums),
+				ResponseBody:   `{"id": 9, "name": "SHA256SUMS"}`,
+				StatusCode:     http.StatusCreated,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/releases/1/assets?name=SHA256SUMS.sig",
+				ExpectedMethod: "POST",
+				ExpectedBody:   []byte("signature"),
+				ResponseBody:   `{"id": 10, "name": "SHA256SUMS.sig"}`,
+				StatusCode:     http.StatusCreated,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+		client.UploadURL = u
+
+		meta := &Owner{name: "example", v3client: client}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubRelease().Schema, map[string]interface{}{
+			"repository":               "repo",
+			"tag_name":                 "v1.0.0",
+			"asset":                    assets,
+			"checksums_file_name":      "SHA256SUMS",
+			"checksums_signature_path": filepath.Join(dir, "SHA256SUMS.sig"),
+		})
+
+		err := syncReleaseAssets(context.Background(), d, meta, 1)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if v := d.Get("checksums").(string); v != checksums {
+			t.Errorf("Unexpected checksums %q", v)
+		}
+	})
+}
@@ -752,6 +752,13 @@ func resourceGithubRepositoryUpdate(d *schema.ResourceData, meta interface{}) er
 	// handle visibility updates separately from other fields
 	repoReq.Visibility = nil
 
+	// The security and analysis settings may be managed with
+	// github_repository_security_and_analysis instead, so they are only sent
+	// when they changed to avoid reverting changes made there.
+	if !d.HasChange("security_and_analysis") {
+		repoReq.SecurityAndAnalysis = nil
+	}
+
 	// The documentation for `default_branch` states: "This can only be set
 	// after a repository has already been created". However, for backwards
 	// compatibility we need to allow terraform configurations that set
@@ -123,6 +123,12 @@ func resourceGithubRepositoryCollaboratorRead(d *schema.ResourceData, meta inter
 
 		permissionName := getPermission(invitation.GetPermissions())
 
+		// Invitations only report the base role a custom role is derived
+		// from, so keep a custom role from state rather than causing a diff.
+		if current := d.Get("permission").(string); current != "" && !isBaseRepositoryPermission(current) {
+			permissionName = current
+		}
+
 		if err = d.Set("repository", repoName); err != nil {
 			return err
 		}
@@ -138,10 +144,16 @@ func resourceGithubRepositoryCollaboratorRead(d *schema.ResourceData, meta inter
 		return nil
 	}
 
-	// Next, check if the user has accepted the invite and is a full collaborator
-	opt := &github.ListCollaboratorsOptions{ListOptions: github.ListOptions{
-		PerPage: maxPerPage,
-	}}
+	// Next, check if the user has accepted the invite and is a full collaborator.
+	// Only direct collaborators are listed so that role_name reflects the role
+	// granted to the user on this repository, including custom roles, rather
+	// than the highest role inherited through the organization or a team.
+	opt := &github.ListCollaboratorsOptions{
+		Affiliation: "direct",
+		ListOptions: github.ListOptions{
+			PerPage: maxPerPage,
+		},
+	}
 
 	for {
 		collaborators, resp, err := client.Repositories.ListCollaborators(ctx,
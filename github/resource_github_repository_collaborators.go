@@ -477,12 +477,16 @@ func resourceGithubRepositoryCollaboratorsCreate(d *schema.ResourceData, meta in
 	}
 
 	err = matchUserCollaboratorsAndInvites(repoName, users, userCollaborators, invitations, meta)
-	if err != nil {
-		return err
+	if err == nil {
+		err = matchTeamCollaborators(repoName, teams, teamCollaborators, meta)
 	}
-
-	err = matchTeamCollaborators(repoName, teams, teamCollaborators, meta)
 	if err != nil {
+		// Collaborators are matched against what exists in GitHub, so a new
+		// resource can simply be created again; an existing one records the
+		// changes applied so far.
+		if d.Id() != "" {
+			return recordPartialUpdate(err, d, meta, resourceGithubRepositoryCollaboratorsRead)
+		}
 		return err
 	}
 
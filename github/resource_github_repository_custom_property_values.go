@@ -0,0 +1,277 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// repositoryCustomPropertyValue is sent instead of github.CustomPropertyValue
+// because the latter omits a nil value, which is how a value is unset.
+type repositoryCustomPropertyValue struct {
+	PropertyName string      `json:"property_name"`
+	Value        interface{} `json:"value"`
+}
+
+func resourceGithubRepositoryCustomPropertyValues() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryCustomPropertyValuesCreateOrUpdate,
+		Read:   resourceGithubRepositoryCustomPropertyValuesRead,
+		Update: resourceGithubRepositoryCustomPropertyValuesCreateOrUpdate,
+		Delete: resourceGithubRepositoryCustomPropertyValuesDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := d.Set("repository", d.Id()); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"properties": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The custom property values of the repository, keyed by property name. Values of 'multi_select' properties are comma-separated.",
+			},
+		},
+
+		CustomizeDiff: resourceGithubRepositoryCustomPropertyValuesDiff,
+	}
+}
+
+// resourceGithubRepositoryCustomPropertyValuesDiff validates the values
+// against the organization's custom properties schema at plan time.
+func resourceGithubRepositoryCustomPropertyValuesDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("properties") {
+		return nil
+	}
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	definitions, _, err := client.Organizations.GetAllCustomProperties(ctx, orgName)
+	if err != nil {
+		return err
+	}
+
+	_, err = expandRepositoryCustomPropertyValues(diff.Get("properties").(map[string]interface{}), definitions)
+	return err
+}
+
+func resourceGithubRepositoryCustomPropertyValuesCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	definitions, _, err := client.Organizations.GetAllCustomProperties(ctx, orgName)
+	if err != nil {
+		return err
+	}
+
+	values, err := expandRepositoryCustomPropertyValues(d.Get("properties").(map[string]interface{}), definitions)
+	if err != nil {
+		return err
+	}
+
+	// Unset the values of properties that were removed from the configuration.
+	if d.HasChange("properties") {
+		o, n := d.GetChange("properties")
+		for name := range o.(map[string]interface{}) {
+			if _, ok := n.(map[string]interface{})[name]; !ok {
+				values = append(values, &repositoryCustomPropertyValue{PropertyName: name})
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Setting %d custom property values for repository %s/%s", len(values), orgName, repoName)
+	err = updateRepositoryCustomPropertyValues(ctx, client, orgName, repoName, values)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+
+	return resourceGithubRepositoryCustomPropertyValuesRead(d, meta)
+}
+
+func resourceGithubRepositoryCustomPropertyValuesRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	repoName := d.Id()
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	values, resp, err := client.Repositories.GetAllCustomPropertyValues(ctx, orgName, repoName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing custom property values of repository %s/%s from state because it no longer exists in GitHub",
+				orgName, repoName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Only values of the properties managed by this resource are tracked, so
+	// that values set elsewhere don't show up as drift. On import nothing is
+	// managed yet and every value that is set is tracked.
+	managed := d.Get("properties").(map[string]interface{})
+
+	properties := make(map[string]interface{})
+	for _, value := range values {
+		if _, ok := managed[value.PropertyName]; len(managed) > 0 && !ok {
+			continue
+		}
+		switch v := value.Value.(type) {
+		case string:
+			properties[value.PropertyName] = v
+		case []string:
+			properties[value.PropertyName] = strings.Join(v, ",")
+		}
+	}
+
+	if err = d.Set("repository", repoName); err != nil {
+		return err
+	}
+	return d.Set("properties", properties)
+}
+
+func resourceGithubRepositoryCustomPropertyValuesDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	var values []*repositoryCustomPropertyValue
+	for name := range d.Get("properties").(map[string]interface{}) {
+		values = append(values, &repositoryCustomPropertyValue{PropertyName: name})
+	}
+
+	log.Printf("[DEBUG] Unsetting %d custom property values for repository %s/%s", len(values), orgName, repoName)
+	err = updateRepositoryCustomPropertyValues(ctx, client, orgName, repoName, values)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func updateRepositoryCustomPropertyValues(ctx context.Context, client *github.Client, owner, repo string, values []*repositoryCustomPropertyValue) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	body := struct {
+		Properties []*repositoryCustomPropertyValue `json:"properties"`
+	}{
+		Properties: values,
+	}
+
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("repos/%s/%s/properties/values", owner, repo), body)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
+
+// expandRepositoryCustomPropertyValues converts the configured values to the
+// types the API expects, returning an error for properties that don't exist
+// in the organization and for values the property doesn't allow.
+func expandRepositoryCustomPropertyValues(properties map[string]interface{}, definitions []*github.CustomProperty) ([]*repositoryCustomPropertyValue, error) {
+	byName := make(map[string]*github.CustomProperty, len(definitions))
+	for _, definition := range definitions {
+		byName[definition.GetPropertyName()] = definition
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make([]*repositoryCustomPropertyValue, 0, len(names))
+	for _, name := range names {
+		raw := properties[name].(string)
+
+		definition, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("custom property %q is not defined in the organization", name)
+		}
+
+		var value interface{} = raw
+		switch definition.ValueType {
+		case "single_select":
+			if err := checkCustomPropertyAllowedValue(definition, raw); err != nil {
+				return nil, err
+			}
+		case "multi_select":
+			selected := strings.Split(raw, ",")
+			for _, v := range selected {
+				if err := checkCustomPropertyAllowedValue(definition, v); err != nil {
+					return nil, err
+				}
+			}
+			value = selected
+		case "true_false":
+			if raw != "true" && raw != "false" {
+				return nil, fmt.Errorf("custom property %q must be \"true\" or \"false\", got %q", name, raw)
+			}
+		}
+
+		values = append(values, &repositoryCustomPropertyValue{PropertyName: name, Value: value})
+	}
+
+	return values, nil
+}
+
+func checkCustomPropertyAllowedValue(definition *github.CustomProperty, value string) error {
+	for _, allowed := range definition.AllowedValues {
+		if value == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("%q is not an allowed value of custom property %q, expected one of: %s",
+		value, definition.GetPropertyName(), strings.Join(definition.AllowedValues, ", "))
+}
@@ -0,0 +1,132 @@
+package github
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestExpandRepositoryCustomPropertyValues(t *testing.T) {
+
+	definitions := []*github.CustomProperty{
+		{PropertyName: github.String("team"), ValueType: "string"},
+		{PropertyName: github.String("env"), ValueType: "single_select", AllowedValues: []string{"production", "staging"}},
+		{PropertyName: github.String("regions"), ValueType: "multi_select", AllowedValues: []string{"eu", "us"}},
+		{PropertyName: github.String("public"), ValueType: "true_false"},
+	}
+
+	t.Run("converts values to the property types", func(t *testing.T) {
+		values, err := expandRepositoryCustomPropertyValues(map[string]interface{}{
+			"team":    "platform",
+			"env":     "staging",
+			"regions": "eu,us",
+			"public":  "false",
+		}, definitions)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		expected := []*repositoryCustomPropertyValue{
+			{PropertyName: "env", Value: "staging"},
+			{PropertyName: "public", Value: "false"},
+			{PropertyName: "regions", Value: []string{"eu", "us"}},
+			{PropertyName: "team", Value: "platform"},
+		}
+		if !reflect.DeepEqual(values, expected) {
+			t.Errorf("unexpected values: %#v", values)
+		}
+	})
+
+	invalid := []struct {
+		properties map[string]interface{}
+		err        string
+	}{
+		{properties: map[string]interface{}{"owner": "platform"}, err: `"owner" is not defined`},
+		{properties: map[string]interface{}{"env": "development"}, err: `"development" is not an allowed value`},
+		{properties: map[string]interface{}{"regions": "eu,ap"}, err: `"ap" is not an allowed value`},
+		{properties: map[string]interface{}{"public": "yes"}, err: `must be "true" or "false"`},
+	}
+
+	for _, tc := range invalid {
+		_, err := expandRepositoryCustomPropertyValues(tc.properties, definitions)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("expected error containing %q for %v, got %v", tc.err, tc.properties, err)
+		}
+	}
+}
+
+func TestAccGithubRepositoryCustomPropertyValues(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("sets custom property values without error", func(t *testing.T) {
+
+		config := `
+			resource "github_organization_custom_properties_schema" "test" {
+			  property {
+			    name           = "tf-acc-test-env-%[1]s"
+			    value_type     = "single_select"
+			    allowed_values = ["production", "staging"]
+			  }
+			}
+
+			resource "github_repository" "test" {
+			  name = "tf-acc-test-%[1]s"
+			}
+
+			resource "github_repository_custom_property_values" "test" {
+			  repository = github_repository.test.name
+			  properties = {
+			    "tf-acc-test-env-%[1]s" = "%[2]s"
+			  }
+
+			  depends_on = [github_organization_custom_properties_schema.test]
+			}
+		`
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, randomID, "staging"),
+						Check: resource.TestCheckResourceAttr(
+							"github_repository_custom_property_values.test",
+							fmt.Sprintf("properties.tf-acc-test-env-%s", randomID), "staging",
+						),
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, "production"),
+						Check: resource.TestCheckResourceAttr(
+							"github_repository_custom_property_values.test",
+							fmt.Sprintf("properties.tf-acc-test-env-%s", randomID), "production",
+						),
+					},
+					{
+						Config:      fmt.Sprintf(config, randomID, "development"),
+						ExpectError: regexp.MustCompile(`is not an allowed value`),
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -0,0 +1,158 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryDependabotAlertsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryDependabotAlertsConfigurationCreateOrUpdate,
+		Read:   resourceGithubRepositoryDependabotAlertsConfigurationRead,
+		Update: resourceGithubRepositoryDependabotAlertsConfigurationCreateOrUpdate,
+		Delete: resourceGithubRepositoryDependabotAlertsConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"alerts_enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether Dependabot alerts are enabled for the repository.",
+			},
+			"automated_security_fixes_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether Dependabot automated security fixes are enabled for the repository. Requires 'alerts_enabled' to be 'true'.",
+			},
+			"automated_security_fixes_paused": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Dependabot automated security fixes are paused for the repository.",
+			},
+		},
+	}
+}
+
+func resourceGithubRepositoryDependabotAlertsConfigurationCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	alertsEnabled := d.Get("alerts_enabled").(bool)
+	fixesEnabled := d.Get("automated_security_fixes_enabled").(bool)
+
+	if fixesEnabled && !alertsEnabled {
+		return fmt.Errorf("automated_security_fixes_enabled requires alerts_enabled to be true")
+	}
+
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	// Automated security fixes depend on the alerts, so the alerts are enabled
+	// before the fixes and disabled after them.
+	if alertsEnabled {
+		log.Printf("[DEBUG] Enabling Dependabot alerts for repository %s/%s", owner, repoName)
+		if _, err := client.Repositories.EnableVulnerabilityAlerts(ctx, owner, repoName); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if fixesEnabled {
+		log.Printf("[DEBUG] Enabling Dependabot automated security fixes for repository %s/%s", owner, repoName)
+		_, err = client.Repositories.EnableAutomatedSecurityFixes(ctx, owner, repoName)
+	} else {
+		log.Printf("[DEBUG] Disabling Dependabot automated security fixes for repository %s/%s", owner, repoName)
+		_, err = client.Repositories.DisableAutomatedSecurityFixes(ctx, owner, repoName)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !alertsEnabled {
+		log.Printf("[DEBUG] Disabling Dependabot alerts for repository %s/%s", owner, repoName)
+		if _, err := client.Repositories.DisableVulnerabilityAlerts(ctx, owner, repoName); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(repoName)
+
+	return resourceGithubRepositoryDependabotAlertsConfigurationRead(d, meta)
+}
+
+func resourceGithubRepositoryDependabotAlertsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	// The vulnerability alerts endpoint reports a missing repository the same
+	// way as disabled alerts, so the repository's existence is checked first.
+	_, _, err := client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing Dependabot alerts configuration of repository %s/%s from state because the repository no longer exists in GitHub",
+					owner, repoName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	alertsEnabled, _, err := client.Repositories.GetVulnerabilityAlerts(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	fixes, _, err := client.Repositories.GetAutomatedSecurityFixes(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	if err = d.Set("repository", repoName); err != nil {
+		return err
+	}
+	if err = d.Set("alerts_enabled", alertsEnabled); err != nil {
+		return err
+	}
+	if err = d.Set("automated_security_fixes_enabled", fixes.GetEnabled()); err != nil {
+		return err
+	}
+	return d.Set("automated_security_fixes_paused", fixes.GetPaused())
+}
+
+func resourceGithubRepositoryDependabotAlertsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Disabling Dependabot automated security fixes and alerts for repository %s/%s", owner, repoName)
+	_, err := client.Repositories.DisableAutomatedSecurityFixes(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Repositories.DisableVulnerabilityAlerts(ctx, owner, repoName)
+	return err
+}
@@ -0,0 +1,77 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubRepositoryDependabotAlertsConfiguration(t *testing.T) {
+	t.Run("reads the alerts and fixes from GitHub", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"name": "repo"}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/vulnerability-alerts",
+				ExpectedMethod: "GET",
+				StatusCode:     http.StatusNoContent,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/automated-security-fixes",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"enabled": true, "paused": true}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryDependabotAlertsConfiguration().Schema, map[string]interface{}{
+			"repository":     "repo",
+			"alerts_enabled": false,
+		})
+		d.SetId("repo")
+
+		err := resourceGithubRepositoryDependabotAlertsConfigurationRead(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if !d.Get("alerts_enabled").(bool) {
+			t.Error("Expected alerts to be enabled")
+		}
+		if !d.Get("automated_security_fixes_enabled").(bool) {
+			t.Error("Expected automated security fixes to be enabled")
+		}
+		if !d.Get("automated_security_fixes_paused").(bool) {
+			t.Error("Expected automated security fixes to be paused")
+		}
+	})
+
+	t.Run("requires alerts for automated security fixes", func(t *testing.T) {
+		meta := &Owner{name: "example", v3client: github.NewClient(nil)}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryDependabotAlertsConfiguration().Schema, map[string]interface{}{
+			"repository":                       "repo",
+			"alerts_enabled":                   false,
+			"automated_security_fixes_enabled": true,
+		})
+
+		err := resourceGithubRepositoryDependabotAlertsConfigurationCreateOrUpdate(d, meta)
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+	})
+}
@@ -0,0 +1,139 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryDeploymentProtectionRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryDeploymentProtectionRuleCreate,
+		Read:   resourceGithubRepositoryDeploymentProtectionRuleRead,
+		Delete: resourceGithubRepositoryDeploymentProtectionRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository. The name is not case sensitive.",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the environment.",
+			},
+			"integration_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the GitHub App that provides the custom deployment protection rule.",
+			},
+			"app_slug": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The slug of the GitHub App that provides the custom deployment protection rule.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the custom deployment protection rule is enabled.",
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubRepositoryDeploymentProtectionRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	escapedEnvName := url.PathEscape(d.Get("environment").(string))
+
+	createData := github.CustomDeploymentProtectionRuleRequest{
+		IntegrationID: github.Int64(int64(d.Get("integration_id").(int))),
+	}
+
+	rule, _, err := client.Repositories.CreateCustomDeploymentProtectionRule(ctx, owner, repoName, escapedEnvName, &createData)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildThreePartID(repoName, escapedEnvName, strconv.FormatInt(rule.GetID(), 10)))
+	return resourceGithubRepositoryDeploymentProtectionRuleRead(d, meta)
+}
+
+func resourceGithubRepositoryDeploymentProtectionRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	owner := meta.(*Owner).name
+	repoName, envName, ruleIdString, err := parseThreePartID(d.Id(), "repository", "environment", "protectionRuleId")
+	if err != nil {
+		return err
+	}
+
+	ruleId, err := strconv.ParseInt(ruleIdString, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(ruleIdString, err)
+	}
+
+	rule, _, err := client.Repositories.GetCustomDeploymentProtectionRule(ctx, owner, repoName, envName, ruleId)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing deployment protection rule for %s/%s/%s from state because it no longer exists in GitHub",
+					owner, repoName, envName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	unescapedEnvName, err := url.PathUnescape(envName)
+	if err != nil {
+		return err
+	}
+
+	d.Set("repository", repoName)
+	d.Set("environment", unescapedEnvName)
+	d.Set("integration_id", rule.GetApp().GetID())
+	d.Set("app_slug", rule.GetApp().GetSlug())
+	d.Set("enabled", rule.GetEnabled())
+	d.Set("node_id", rule.GetNodeID())
+	return nil
+}
+
+func resourceGithubRepositoryDeploymentProtectionRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	owner := meta.(*Owner).name
+	repoName, envName, ruleIdString, err := parseThreePartID(d.Id(), "repository", "environment", "protectionRuleId")
+	if err != nil {
+		return err
+	}
+
+	ruleId, err := strconv.ParseInt(ruleIdString, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(ruleIdString, err)
+	}
+
+	_, err = client.Repositories.DisableCustomDeploymentProtectionRule(ctx, owner, repoName, envName, ruleId)
+	return err
+}
@@ -0,0 +1,81 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubRepositoryDeploymentProtectionRule(t *testing.T) {
+	const APP_ID = "GITHUB_TEST_DEPLOYMENT_PROTECTION_RULE_APP_ID"
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	appID, exists := os.LookupEnv(APP_ID)
+
+	t.Run("enables a custom deployment protection rule on an environment", func(t *testing.T) {
+		if !exists {
+			t.Skipf("%s environment variable is missing", APP_ID)
+		}
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name = "tf-acc-test-%s"
+			}
+
+			resource "github_repository_environment" "test" {
+				repository  = github_repository.test.name
+				environment = "environment / test"
+			}
+
+			resource "github_repository_deployment_protection_rule" "test" {
+				repository     = github_repository.test.name
+				environment    = github_repository_environment.test.environment
+				integration_id = %s
+			}
+		`, randomID, appID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(
+				"github_repository_deployment_protection_rule.test", "integration_id", appID,
+			),
+			resource.TestCheckResourceAttr(
+				"github_repository_deployment_protection_rule.test", "enabled", "true",
+			),
+			resource.TestCheckResourceAttrSet(
+				"github_repository_deployment_protection_rule.test", "app_slug",
+			),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+					{
+						ResourceName:      "github_repository_deployment_protection_rule.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -2,9 +2,11 @@ package github
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -70,6 +72,18 @@ func resourceGithubRepositoryEnvironment() *schema.Resource {
 							Elem:        &schema.Schema{Type: schema.TypeInt},
 							Description: "Up to 6 IDs for users who may review jobs that reference the environment. Reviewers must have at least read access to the repository. Only one of the required reviewers needs to approve the job for it to proceed.",
 						},
+						"team_slugs": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Slugs of teams who may review jobs that reference the environment, as an alternative to their IDs.",
+						},
+						"user_logins": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Logins of users who may review jobs that reference the environment, as an alternative to their IDs.",
+						},
 					},
 				},
 			},
@@ -104,11 +118,14 @@ func resourceGithubRepositoryEnvironmentCreate(d *schema.ResourceData, meta inte
 	repoName := d.Get("repository").(string)
 	envName := d.Get("environment").(string)
 	escapedEnvName := url.PathEscape(envName)
-	updateData := createUpdateEnvironmentData(d, meta)
-
 	ctx := context.Background()
 
-	_, _, err := client.Repositories.CreateUpdateEnvironment(ctx, owner, repoName, escapedEnvName, &updateData)
+	updateData, err := createUpdateEnvironmentData(ctx, d, meta)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = client.Repositories.CreateUpdateEnvironment(ctx, owner, repoName, escapedEnvName, &updateData)
 
 	if err != nil {
 		return err
@@ -159,23 +176,39 @@ func resourceGithubRepositoryEnvironmentRead(d *schema.ResourceData, meta interf
 		case "required_reviewers":
 			teams := make([]int64, 0)
 			users := make([]int64, 0)
+			teamSlugs := make([]string, 0)
+			userLogins := make([]string, 0)
+
+			// Reviewers configured by slug or login are kept that way, with
+			// the configured casing, all others (e.g. on import) are recorded
+			// by ID.
+			configuredTeamSlugs := configuredReviewerNames(d, "team_slugs")
+			configuredUserLogins := configuredReviewerNames(d, "user_logins")
 
 			for _, r := range pr.Reviewers {
 				switch *r.Type {
 				case "Team":
-					if r.Reviewer.(*github.Team).ID != nil {
-						teams = append(teams, *r.Reviewer.(*github.Team).ID)
+					team := r.Reviewer.(*github.Team)
+					if slug, ok := configuredTeamSlugs[strings.ToLower(team.GetSlug())]; ok {
+						teamSlugs = append(teamSlugs, slug)
+					} else if team.ID != nil {
+						teams = append(teams, *team.ID)
 					}
 				case "User":
-					if r.Reviewer.(*github.User).ID != nil {
-						users = append(users, *r.Reviewer.(*github.User).ID)
+					user := r.Reviewer.(*github.User)
+					if login, ok := configuredUserLogins[strings.ToLower(user.GetLogin())]; ok {
+						userLogins = append(userLogins, login)
+					} else if user.ID != nil {
+						users = append(users, *user.ID)
 					}
 				}
 			}
 			if err = d.Set("reviewers", []interface{}{
 				map[string]interface{}{
-					"teams": teams,
-					"users": users,
+					"teams":       teams,
+					"users":       users,
+					"team_slugs":  teamSlugs,
+					"user_logins": userLogins,
 				},
 			}); err != nil {
 				return err
@@ -210,10 +243,13 @@ func resourceGithubRepositoryEnvironmentUpdate(d *schema.ResourceData, meta inte
 	repoName := d.Get("repository").(string)
 	envName := d.Get("environment").(string)
 	escapedEnvName := url.PathEscape(envName)
-	updateData := createUpdateEnvironmentData(d, meta)
-
 	ctx := context.Background()
 
+	updateData, err := createUpdateEnvironmentData(ctx, d, meta)
+	if err != nil {
+		return err
+	}
+
 	resultKey, _, err := client.Repositories.CreateUpdateEnvironment(ctx, owner, repoName, escapedEnvName, &updateData)
 	if err != nil {
 		return err
@@ -240,7 +276,7 @@ func resourceGithubRepositoryEnvironmentDelete(d *schema.ResourceData, meta inte
 	return err
 }
 
-func createUpdateEnvironmentData(d *schema.ResourceData, meta interface{}) github.CreateUpdateEnvironment {
+func createUpdateEnvironmentData(ctx context.Context, d *schema.ResourceData, meta interface{}) (github.CreateUpdateEnvironment, error) {
 	data := github.CreateUpdateEnvironment{}
 
 	if v, ok := d.GetOk("wait_timer"); ok {
@@ -268,6 +304,31 @@ func createUpdateEnvironmentData(d *schema.ResourceData, meta interface{}) githu
 			})
 		}
 
+		client := meta.(*Owner).v3client
+		owner := meta.(*Owner).name
+
+		for _, slug := range expandReviewerNames(v, "team_slugs") {
+			team, _, err := client.Teams.GetTeamBySlug(ctx, owner, slug)
+			if err != nil {
+				return data, fmt.Errorf("error resolving environment reviewer team %q: %s", slug, err)
+			}
+			envReviewers = append(envReviewers, &github.EnvReviewers{
+				Type: github.String("Team"),
+				ID:   team.ID,
+			})
+		}
+
+		for _, login := range expandReviewerNames(v, "user_logins") {
+			user, _, err := client.Users.Get(ctx, login)
+			if err != nil {
+				return data, fmt.Errorf("error resolving environment reviewer user %q: %s", login, err)
+			}
+			envReviewers = append(envReviewers, &github.EnvReviewers{
+				Type: github.String("User"),
+				ID:   user.ID,
+			})
+		}
+
 		data.Reviewers = envReviewers
 	}
 
@@ -279,7 +340,7 @@ func createUpdateEnvironmentData(d *schema.ResourceData, meta interface{}) githu
 		}
 	}
 
-	return data
+	return data, nil
 }
 
 func expandReviewers(v interface{}, target string) []int64 {
@@ -295,3 +356,28 @@ func expandReviewers(v interface{}, target string) []int64 {
 	}
 	return res
 }
+
+func expandReviewerNames(v interface{}, target string) []string {
+	res := make([]string, 0)
+	m := v.([]interface{})[0]
+	if m != nil {
+		if v, ok := m.(map[string]interface{})[target]; ok {
+			for _, v := range v.(*schema.Set).List() {
+				res = append(res, v.(string))
+			}
+		}
+	}
+	return res
+}
+
+// configuredReviewerNames returns the team slugs or user logins currently
+// configured as reviewers, keyed by their lower cased form.
+func configuredReviewerNames(d *schema.ResourceData, target string) map[string]string {
+	res := make(map[string]string)
+	if v, ok := d.GetOk("reviewers"); ok {
+		for _, name := range expandReviewerNames(v, target) {
+			res[strings.ToLower(name)] = name
+		}
+	}
+	return res
+}
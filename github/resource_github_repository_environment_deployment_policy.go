@@ -34,14 +34,44 @@ func resourceGithubRepositoryEnvironmentDeploymentPolicy() *schema.Resource {
 				Description: "The name of the environment.",
 			},
 			"branch_pattern": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    false,
-				Description: "The name pattern that branches must match in order to deploy to the environment.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     false,
+				ExactlyOneOf: []string{"branch_pattern", "tag_pattern"},
+				Description:  "The name pattern that branches must match in order to deploy to the environment.",
+			},
+			"tag_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     false,
+				ExactlyOneOf: []string{"branch_pattern", "tag_pattern"},
+				Description:  "The name pattern that tags must match in order to deploy to the environment.",
 			},
 		},
+		CustomizeDiff: resourceGithubRepositoryEnvironmentDeploymentPolicyDiff,
+	}
+
+}
+
+// The type of a deployment policy can't be updated, so switching between a
+// branch and a tag pattern replaces the policy.
+func resourceGithubRepositoryEnvironmentDeploymentPolicyDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("tag_pattern") {
+		return nil
+	}
+	o, n := diff.GetChange("tag_pattern")
+	if o.(string) == "" || n.(string) == "" {
+		return diff.ForceNew("tag_pattern")
 	}
+	return nil
+}
 
+// deploymentPolicyPattern returns the configured pattern and its policy type.
+func deploymentPolicyPattern(d *schema.ResourceData) (string, string) {
+	if tagPattern, ok := d.GetOk("tag_pattern"); ok {
+		return tagPattern.(string), "tag"
+	}
+	return d.Get("branch_pattern").(string), "branch"
 }
 
 func resourceGithubRepositoryEnvironmentDeploymentPolicyCreate(d *schema.ResourceData, meta interface{}) error {
@@ -51,11 +81,12 @@ func resourceGithubRepositoryEnvironmentDeploymentPolicyCreate(d *schema.Resourc
 	owner := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
 	envName := d.Get("environment").(string)
-	branchPattern := d.Get("branch_pattern").(string)
+	pattern, policyType := deploymentPolicyPattern(d)
 	escapedEnvName := url.PathEscape(envName)
 
 	createData := github.DeploymentBranchPolicyRequest{
-		Name: github.String(branchPattern),
+		Name: github.String(pattern),
+		Type: github.String(policyType),
 	}
 
 	resultKey, _, err := client.Repositories.CreateDeploymentBranchPolicy(ctx, owner, repoName, escapedEnvName, &createData)
@@ -98,7 +129,13 @@ func resourceGithubRepositoryEnvironmentDeploymentPolicyRead(d *schema.ResourceD
 		return err
 	}
 
-	d.Set("branch_pattern", branchPolicy.GetName())
+	if branchPolicy.GetType() == "tag" {
+		d.Set("tag_pattern", branchPolicy.GetName())
+		d.Set("branch_pattern", "")
+	} else {
+		d.Set("branch_pattern", branchPolicy.GetName())
+		d.Set("tag_pattern", "")
+	}
 	return nil
 }
 
@@ -109,7 +146,7 @@ func resourceGithubRepositoryEnvironmentDeploymentPolicyUpdate(d *schema.Resourc
 	owner := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
 	envName := d.Get("environment").(string)
-	branchPattern := d.Get("branch_pattern").(string)
+	pattern, _ := deploymentPolicyPattern(d)
 	escapedEnvName := url.PathEscape(envName)
 	_, _, branchPolicyIdString, err := parseThreePartID(d.Id(), "repository", "environment", "branchPolicyId")
 	if err != nil {
@@ -122,7 +159,7 @@ func resourceGithubRepositoryEnvironmentDeploymentPolicyUpdate(d *schema.Resourc
 	}
 
 	updateData := github.DeploymentBranchPolicyRequest{
-		Name: github.String(branchPattern),
+		Name: github.String(pattern),
 	}
 
 	resultKey, _, err := client.Repositories.UpdateDeploymentBranchPolicy(ctx, owner, repoName, escapedEnvName, branchPolicyId, &updateData)
@@ -86,4 +86,82 @@ func TestAccGithubRepositoryEnvironmentDeploymentPolicy(t *testing.T) {
 		})
 
 	})
+
+	t.Run("creates and updates a tag deployment policy", func(t *testing.T) {
+
+		config := func(pattern string) string {
+			return fmt.Sprintf(`
+
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-tag-%s"
+			}
+
+			resource "github_repository_environment" "test" {
+				repository 	= github_repository.test.name
+				environment	= "environment / test"
+				deployment_branch_policy {
+					protected_branches     = false
+					custom_branch_policies = true
+				}
+			}
+
+			resource "github_repository_environment_deployment_policy" "test" {
+				repository 	   = github_repository.test.name
+				environment	   = github_repository_environment.test.environment
+				%s
+			}
+
+		`, randomID, pattern)
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config(`tag_pattern = "v*"`),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(
+								"github_repository_environment_deployment_policy.test", "tag_pattern", "v*",
+							),
+							resource.TestCheckResourceAttr(
+								"github_repository_environment_deployment_policy.test", "branch_pattern", "",
+							),
+						),
+					},
+					{
+						Config: config(`tag_pattern = "v1.*"`),
+						Check: resource.TestCheckResourceAttr(
+							"github_repository_environment_deployment_policy.test", "tag_pattern", "v1.*",
+						),
+					},
+					{
+						Config: config(`branch_pattern = "releases/*"`),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(
+								"github_repository_environment_deployment_policy.test", "branch_pattern", "releases/*",
+							),
+							resource.TestCheckResourceAttr(
+								"github_repository_environment_deployment_policy.test", "tag_pattern", "",
+							),
+						),
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
 }
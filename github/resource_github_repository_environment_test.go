@@ -2,10 +2,13 @@ package github
 
 import (
 	"fmt"
+	"net/url"
 	"testing"
 
+	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccGithubRepositoryEnvironment(t *testing.T) {
@@ -75,4 +78,112 @@ func TestAccGithubRepositoryEnvironment(t *testing.T) {
 		})
 
 	})
+	t.Run("creates a repository environment with reviewers by login", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+
+			data "github_user" "current" {
+				username = ""
+			}
+
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-login-%s"
+				visibility = "public"
+			}
+
+			resource "github_repository_environment" "test" {
+				repository 	= github_repository.test.name
+				environment	= "test"
+				reviewers {
+					user_logins = [data.github_user.current.login]
+				}
+			}
+
+		`, randomID)
+
+		check := resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("github_repository_environment.test", "reviewers.0.user_logins.#", "1"),
+			resource.TestCheckResourceAttr("github_repository_environment.test", "reviewers.0.users.#", "0"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
+
+func TestRepositoryEnvironmentReadReviewerNames(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/example/repo/environments/production",
+			ResponseBody: `{
+  "name": "production",
+  "protection_rules": [
+    {
+      "type": "required_reviewers",
+      "reviewers": [
+        {"type": "Team", "reviewer": {"id": 1, "slug": "core-team"}},
+        {"type": "User", "reviewer": {"id": 2, "login": "octocat"}},
+        {"type": "User", "reviewer": {"id": 3, "login": "hubot"}}
+      ]
+    }
+  ]
+}`,
+			StatusCode: 200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	d := schema.TestResourceDataRaw(t, resourceGithubRepositoryEnvironment().Schema, map[string]interface{}{
+		"repository":  "repo",
+		"environment": "production",
+		"reviewers": []interface{}{
+			map[string]interface{}{
+				"team_slugs":  []interface{}{"Core-Team"},
+				"user_logins": []interface{}{"Octocat"},
+			},
+		},
+	})
+	d.SetId("repo:production")
+
+	err := resourceGithubRepositoryEnvironmentRead(d, &Owner{name: "example", v3client: client})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	if slugs := d.Get("reviewers.0.team_slugs").(*schema.Set); slugs.Len() != 1 || !slugs.Contains("Core-Team") {
+		t.Errorf("Expected team_slugs [Core-Team], got %v", slugs.List())
+	}
+	if logins := d.Get("reviewers.0.user_logins").(*schema.Set); logins.Len() != 1 || !logins.Contains("Octocat") {
+		t.Errorf("Expected user_logins [Octocat], got %v", logins.List())
+	}
+	if users := d.Get("reviewers.0.users").(*schema.Set); users.Len() != 1 || !users.Contains(3) {
+		t.Errorf("Expected users [3], got %v", users.List())
+	}
 }
@@ -0,0 +1,72 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryInteractionLimits() *schema.Resource {
+	s := interactionLimitsSchema()
+	s["repository"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the repository.",
+	}
+
+	return &schema.Resource{
+		Create: resourceGithubRepositoryInteractionLimitsCreateOrUpdate,
+		Read:   resourceGithubRepositoryInteractionLimitsRead,
+		Update: resourceGithubRepositoryInteractionLimitsCreateOrUpdate,
+		Delete: resourceGithubRepositoryInteractionLimitsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := d.Set("repository", d.Id()); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceGithubRepositoryInteractionLimitsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	ctx := context.Background()
+
+	log.Printf("[DEBUG] Limiting interactions with repository %s/%s to %s", owner, repoName, d.Get("limit").(string))
+	err := setInteractionLimits(ctx, client, fmt.Sprintf("repos/%s/%s/interaction-limits", owner, repoName), d)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+
+	return resourceGithubRepositoryInteractionLimitsRead(d, meta)
+}
+
+func resourceGithubRepositoryInteractionLimitsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	// The limits of the organization are returned for its repositories
+	// without limits of their own.
+	return readInteractionLimits(ctx, client, fmt.Sprintf("repos/%s/%s/interaction-limits", owner, d.Id()), "repository", d)
+}
+
+func resourceGithubRepositoryInteractionLimitsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	_, err := client.Interactions.RemoveRestrictionsFromRepo(ctx, owner, d.Id())
+	return err
+}
@@ -0,0 +1,78 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubRepositoryInteractionLimits(t *testing.T) {
+	newMeta := func(ts string) *Owner {
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts + "/")
+		client.BaseURL = u
+		return &Owner{name: "example", v3client: client}
+	}
+
+	t.Run("limits interactions with the repository", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo/interaction-limits",
+				ExpectedMethod: "PUT",
+				ExpectedBody:   []byte(`{"limit":"existing_users","expiry":"one_day"}` + "\n"),
+				ResponseBody:   `{"limit": "existing_users", "origin": "repository", "expires_at": "2024-01-02T00:00:00Z"}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/interaction-limits",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"limit": "existing_users", "origin": "repository", "expires_at": "2024-01-02T00:00:00Z"}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryInteractionLimits().Schema, map[string]interface{}{
+			"repository": "repo",
+			"limit":      "existing_users",
+		})
+
+		err := resourceGithubRepositoryInteractionLimitsCreateOrUpdate(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "repo" {
+			t.Errorf("Unexpected ID %q", d.Id())
+		}
+	})
+
+	t.Run("ignores the limits of the organization", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo/interaction-limits",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"limit": "collaborators_only", "origin": "organization", "expires_at": "2024-01-02T00:00:00Z"}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryInteractionLimits().Schema, map[string]interface{}{
+			"repository": "repo",
+			"limit":      "existing_users",
+		})
+		d.SetId("repo")
+
+		err := resourceGithubRepositoryInteractionLimitsRead(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if d.Id() != "" {
+			t.Errorf("Expected the limits to be removed from state, got ID %q", d.Id())
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryPrivateVulnerabilityReporting() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryPrivateVulnerabilityReportingCreateOrUpdate,
+		Read:   resourceGithubRepositoryPrivateVulnerabilityReportingRead,
+		Update: resourceGithubRepositoryPrivateVulnerabilityReportingCreateOrUpdate,
+		Delete: resourceGithubRepositoryPrivateVulnerabilityReportingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether private vulnerability reporting is enabled for the repository.",
+			},
+		},
+	}
+}
+
+func resourceGithubRepositoryPrivateVulnerabilityReportingCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	var err error
+	if d.Get("enabled").(bool) {
+		log.Printf("[DEBUG] Enabling private vulnerability reporting for repository %s/%s", owner, repoName)
+		_, err = client.Repositories.EnablePrivateReporting(ctx, owner, repoName)
+	} else {
+		log.Printf("[DEBUG] Disabling private vulnerability reporting for repository %s/%s", owner, repoName)
+		_, err = client.Repositories.DisablePrivateReporting(ctx, owner, repoName)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+
+	return resourceGithubRepositoryPrivateVulnerabilityReportingRead(d, meta)
+}
+
+func resourceGithubRepositoryPrivateVulnerabilityReportingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	enabled, _, err := client.Repositories.IsPrivateReportingEnabled(ctx, owner, repoName)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing private vulnerability reporting of repository %s/%s from state because the repository no longer exists in GitHub",
+					owner, repoName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	if err = d.Set("repository", repoName); err != nil {
+		return err
+	}
+	return d.Set("enabled", enabled)
+}
+
+func resourceGithubRepositoryPrivateVulnerabilityReportingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Disabling private vulnerability reporting for repository %s/%s", owner, repoName)
+	_, err := client.Repositories.DisablePrivateReporting(ctx, owner, repoName)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+	}
+	return err
+}
@@ -0,0 +1,85 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubRepositoryPrivateVulnerabilityReporting(t *testing.T) {
+	t.Run("enables private vulnerability reporting", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo/private-vulnerability-reporting",
+				ExpectedMethod: "PUT",
+				StatusCode:     http.StatusNoContent,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/private-vulnerability-reporting",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"enabled": true}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryPrivateVulnerabilityReporting().Schema, map[string]interface{}{
+			"repository": "repo",
+			"enabled":    true,
+		})
+
+		err := resourceGithubRepositoryPrivateVulnerabilityReportingCreateOrUpdate(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "repo" {
+			t.Errorf("Unexpected ID %q", d.Id())
+		}
+		if !d.Get("enabled").(bool) {
+			t.Error("Expected private vulnerability reporting to be enabled")
+		}
+	})
+
+	t.Run("removes a deleted repository from state", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo/private-vulnerability-reporting",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"message": "Not Found"}`,
+				StatusCode:     http.StatusNotFound,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryPrivateVulnerabilityReporting().Schema, map[string]interface{}{
+			"repository": "repo",
+			"enabled":    true,
+		})
+		d.SetId("repo")
+
+		err := resourceGithubRepositoryPrivateVulnerabilityReportingRead(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "" {
+			t.Errorf("Expected the resource to be removed from state, got ID %q", d.Id())
+		}
+	})
+}
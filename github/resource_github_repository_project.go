@@ -18,6 +18,9 @@ func resourceGithubRepositoryProject() *schema.Resource {
 		Read:   resourceGithubRepositoryProjectRead,
 		Update: resourceGithubRepositoryProjectUpdate,
 		Delete: resourceGithubRepositoryProjectDelete,
+
+		DeprecationMessage: classicProjectDeprecationMessage,
+
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				parts := strings.Split(d.Id(), "/")
@@ -6,22 +6,31 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceGithubRepositoryRuleset() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGithubRepositoryRulesetCreate,
-		Read:   resourceGithubRepositoryRulesetRead,
-		Update: resourceGithubRepositoryRulesetUpdate,
-		Delete: resourceGithubRepositoryRulesetDelete,
+		Create:        resourceGithubRepositoryRulesetCreate,
+		Read:          resourceGithubRepositoryRulesetRead,
+		Update:        resourceGithubRepositoryRulesetUpdate,
+		DeleteContext: resourceGithubRepositoryRulesetDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceGithubRepositoryRulesetImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: bypassActorsCustomizeDiff,
+
 		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
@@ -57,8 +66,14 @@ func resourceGithubRepositoryRuleset() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"actor_id": {
 							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "The ID of the actor that can bypass a ruleset. When `actor_type` is `OrganizationAdmin`, this should be set to `1`.",
+							Optional:    true,
+							Computed:    true,
+							Description: "The ID of the actor that can bypass a ruleset. Required unless `actor_slug` is set. When `actor_type` is `OrganizationAdmin`, this should be set to `1`.",
+						},
+						"actor_slug": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The slug of the team or GitHub App that can bypass a ruleset, resolved to `actor_id` by the provider. Only valid when `actor_type` is `Team` or `Integration`.",
 						},
 						"actor_type": {
 							Type:         schema.TypeString,
@@ -101,17 +116,13 @@ func resourceGithubRepositoryRuleset() *schema.Resource {
 										Type:        schema.TypeList,
 										Required:    true,
 										Description: "Array of ref names or patterns to include. One of these patterns must match for the condition to pass. Also accepts `~DEFAULT_BRANCH` to include the default branch or `~ALL` to include all branches.",
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
+										Elem:        rulesetRefNamePatternSchema(),
 									},
 									"exclude": {
 										Type:        schema.TypeList,
 										Required:    true,
 										Description: "Array of ref names or patterns to exclude. The condition will not pass if any of these patterns match.",
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
+										Elem:        rulesetRefNamePatternSchema(),
 									},
 								},
 							},
@@ -213,6 +224,17 @@ func resourceGithubRepositoryRuleset() *schema.Resource {
 										Default:     false,
 										Description: "All conversations on code must be resolved before a pull request can be merged. Defaults to `false`.",
 									},
+									"allowed_merge_methods": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Computed:    true,
+										MinItems:    1,
+										Description: "The merge methods allowed for pull requests targeting a matching branch. Any of `merge`, `squash` and `rebase`. Defaults to all of them.",
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"merge", "squash", "rebase"}, false), "allowed_merge_methods"),
+										},
+									},
 								},
 							},
 						},
@@ -249,6 +271,89 @@ func resourceGithubRepositoryRuleset() *schema.Resource {
 										Optional:    true,
 										Description: "Whether pull requests targeting a matching branch must be tested with the latest code. This setting will not take effect unless at least one status check is enabled. Defaults to `false`.",
 									},
+									"do_not_enforce_on_create": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Allow repositories and branches to be created if a check would otherwise prohibit it. Defaults to `false`.",
+									},
+								},
+							},
+						},
+						"code_scanning": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Choose which tools must provide code scanning results before the reference is updated. When configured, code scanning must be enabled and have results for both the commit and the reference being updated.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code_scanning_tool": {
+										Type:        schema.TypeSet,
+										MinItems:    1,
+										Required:    true,
+										Description: "Tools that must provide code scanning results for this rule to pass.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tool": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "The name of a code scanning tool.",
+												},
+												"alerts_threshold": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Description:  "The severity level at which code scanning results that raise alerts block a reference update. Can be one of: `none`, `errors`, `errors_and_warnings`, `all`.",
+													ValidateFunc: validation.StringInSlice([]string{"none", "errors", "errors_and_warnings", "all"}, false),
+												},
+												"security_alerts_threshold": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Description:  "The severity level at which code scanning results that raise security alerts block a reference update. Can be one of: `none`, `critical`, `high_or_higher`, `medium_or_higher`, `all`.",
+													ValidateFunc: validation.StringInSlice([]string{"none", "critical", "high_or_higher", "medium_or_higher", "all"}, false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"required_workflows": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Choose which Actions workflows must pass before branches can be merged into a branch that matches this rule.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"required_workflow": {
+										Type:        schema.TypeSet,
+										MinItems:    1,
+										Required:    true,
+										Description: "Actions workflows that are required. Several can be defined.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"repository_id": {
+													Type:        schema.TypeInt,
+													Required:    true,
+													Description: "The repository in which the workflow is defined.",
+												},
+												"path": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "The path to the workflow YAML definition file.",
+												},
+												"ref": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Default:     "master",
+													Description: "The ref (branch or tag) of the workflow file to use.",
+												},
+												"sha": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The commit SHA of the workflow file to use.",
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -412,10 +517,29 @@ func resourceGithubRepositoryRuleset() *schema.Resource {
 					},
 				},
 			},
+			"raw_rules_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "A JSON array of rules of types not supported by `rules`, in the format of the GitHub API. Once set, rules of such types added outside of Terraform are detected as drift.",
+			},
+			"ruleset_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The JSON of the ruleset as returned by the GitHub API.",
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"destroy_grace_period": rulesetDestroyGracePeriodSchema(),
+			"force_overwrite": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to update the ruleset even if it was changed outside of Terraform since it was last read.",
+			},
 		},
 	}
 }
@@ -423,17 +547,33 @@ func resourceGithubRepositoryRuleset() *schema.Resource {
 func resourceGithubRepositoryRulesetCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Owner).v3client
 
+	err := resolveBypassActorSlugs(context.Background(), d, meta)
+	if err != nil {
+		return err
+	}
+
 	rulesetReq := resourceGithubRulesetObject(d, "")
+	rawRules, err := expandRawRules(d.Get("raw_rules_json").(string))
+	if err != nil {
+		return err
+	}
+	rulesetReq.Rules = append(rulesetReq.Rules, rawRules...)
 
 	owner := meta.(*Owner).name
 
 	repoName := d.Get("repository").(string)
+	err = checkBypassActorInstallations(context.Background(), meta, repoName, d.Get("bypass_actors").([]interface{}))
+	if err != nil {
+		return err
+	}
 	ctx := context.Background()
 
-	var ruleset *github.Ruleset
-	var err error
+	err = checkRepositoryRulesetNameAvailable(ctx, client, owner, repoName, rulesetReq.Name)
+	if err != nil {
+		return err
+	}
 
-	ruleset, _, err = client.Repositories.CreateRuleset(ctx, owner, repoName, rulesetReq)
+	ruleset, _, _, _, err := doRulesetRequest(ctx, client, "POST", fmt.Sprintf("repos/%s/%s/rulesets", owner, repoName), rulesetReq)
 	if err != nil {
 		return err
 	}
@@ -458,10 +598,8 @@ func resourceGithubRepositoryRulesetRead(d *schema.ResourceData, meta interface{
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
 
-	var ruleset *github.Ruleset
-	var resp *github.Response
-
-	ruleset, resp, err = client.Repositories.GetRuleset(ctx, owner, repoName, rulesetID, false)
+	u := fmt.Sprintf("repos/%v/%v/rulesets/%v?includes_parents=false", owner, repoName, rulesetID)
+	ruleset, otherRules, rulesetJSON, resp, err := getRulesetJSON(ctx, client, u)
 	if err != nil {
 		if ghErr, ok := err.(*github.ErrorResponse); ok {
 			if ghErr.Response.StatusCode == http.StatusNotModified {
@@ -474,17 +612,29 @@ func resourceGithubRepositoryRulesetRead(d *schema.ResourceData, meta interface{
 				return nil
 			}
 		}
+		return err
 	}
 
 	d.Set("etag", resp.Header.Get("ETag"))
 	d.Set("name", ruleset.Name)
 	d.Set("target", ruleset.GetTarget())
 	d.Set("enforcement", ruleset.Enforcement)
-	d.Set("bypass_actors", flattenBypassActors(ruleset.BypassActors))
+	d.Set("bypass_actors", preserveBypassActorSlugs(flattenBypassActors(ruleset.BypassActors), d.Get("bypass_actors").([]interface{})))
 	d.Set("conditions", flattenConditions(ruleset.GetConditions(), false))
 	d.Set("rules", flattenRules(ruleset.Rules, false))
 	d.Set("node_id", ruleset.GetNodeID())
 	d.Set("ruleset_id", ruleset.ID)
+	d.Set("ruleset_json", string(rulesetJSON))
+
+	// The rules of other types are only read back once raw_rules_json is
+	// used, so configurations that don't use it don't start to drift.
+	if d.Get("raw_rules_json").(string) != "" {
+		rawRules, err := flattenRawRules(otherRules)
+		if err != nil {
+			return err
+		}
+		d.Set("raw_rules_json", rawRules)
+	}
 
 	return nil
 }
@@ -492,11 +642,25 @@ func resourceGithubRepositoryRulesetRead(d *schema.ResourceData, meta interface{
 func resourceGithubRepositoryRulesetUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Owner).v3client
 
+	err := resolveBypassActorSlugs(context.Background(), d, meta)
+	if err != nil {
+		return err
+	}
+
 	rulesetReq := resourceGithubRulesetObject(d, "")
+	rawRules, err := expandRawRules(d.Get("raw_rules_json").(string))
+	if err != nil {
+		return err
+	}
+	rulesetReq.Rules = append(rulesetReq.Rules, rawRules...)
 
 	owner := meta.(*Owner).name
 
 	repoName := d.Get("repository").(string)
+	err = checkBypassActorInstallations(context.Background(), meta, repoName, d.Get("bypass_actors").([]interface{}))
+	if err != nil {
+		return err
+	}
 	rulesetID, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
@@ -504,7 +668,14 @@ func resourceGithubRepositoryRulesetUpdate(d *schema.ResourceData, meta interfac
 
 	ctx := context.WithValue(context.Background(), ctxId, rulesetID)
 
-	ruleset, _, err := client.Repositories.UpdateRuleset(ctx, owner, repoName, rulesetID, rulesetReq)
+	if !d.Get("force_overwrite").(bool) {
+		err = checkRepositoryRulesetUnchanged(ctx, client, owner, repoName, rulesetID, d.Get("etag").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	ruleset, _, _, _, err := doRulesetRequest(ctx, client, "PUT", fmt.Sprintf("repos/%s/%s/rulesets/%d", owner, repoName, rulesetID), rulesetReq)
 	if err != nil {
 		return err
 	}
@@ -513,20 +684,62 @@ func resourceGithubRepositoryRulesetUpdate(d *schema.ResourceData, meta interfac
 	return resourceGithubRepositoryRulesetRead(d, meta)
 }
 
-func resourceGithubRepositoryRulesetDelete(d *schema.ResourceData, meta interface{}) error {
+// checkRepositoryRulesetNameAvailable returns an error pointing to the
+// existing ruleset if the repository already has a ruleset with the name,
+// rather than letting GitHub reject the new one with a validation error.
+func checkRepositoryRulesetNameAvailable(ctx context.Context, client *github.Client, owner, repoName, name string) error {
+	rulesets, _, err := client.Repositories.GetAllRulesets(ctx, owner, repoName, false)
+	if err != nil {
+		return err
+	}
+
+	if existing := findRulesetByName(rulesets, name); existing != nil {
+		return rulesetExistsError(existing, fmt.Sprintf("repository %s/%s", owner, repoName), "github_repository_ruleset",
+			buildTwoPartID(repoName, strconv.FormatInt(existing.GetID(), 10)))
+	}
+	return nil
+}
+
+// checkRepositoryRulesetUnchanged returns an error if the ruleset no longer
+// matches the etag it had when it was last read, so that concurrent applies
+// don't silently overwrite each other's changes.
+func checkRepositoryRulesetUnchanged(ctx context.Context, client *github.Client, owner, repoName string, rulesetID int64, etag string) error {
+	if etag == "" {
+		return nil
+	}
+
+	ctx = context.WithValue(ctx, ctxEtag, etag)
+	_, _, _, _, err := getRulesetJSON(ctx, client, fmt.Sprintf("repos/%s/%s/rulesets/%d?includes_parents=false", owner, repoName, rulesetID))
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotModified {
+			return nil
+		}
+		return err
+	}
+
+	return fmt.Errorf("ruleset %s/%s: %d was changed outside of Terraform since it was last read; "+
+		"refresh and plan again, or set force_overwrite to update it anyway", owner, repoName, rulesetID)
+}
+
+func resourceGithubRepositoryRulesetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 
 	repoName := d.Get("repository").(string)
 	rulesetID, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
-		return unconvertibleIdErr(d.Id(), err)
+		return diag.FromErr(unconvertibleIdErr(d.Id(), err))
+	}
+	ctx = context.WithValue(ctx, ctxId, rulesetID)
+
+	err = disableRulesetBeforeDestroy(ctx, client, fmt.Sprintf("repos/%s/%s/rulesets/%d", owner, repoName, rulesetID), d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, rulesetID)
 
 	log.Printf("[DEBUG] Deleting repository ruleset: %s/%s: %d", owner, repoName, rulesetID)
 	_, err = client.Repositories.DeleteRuleset(ctx, owner, repoName, rulesetID)
-	return err
+	return diag.FromErr(err)
 }
 
 func resourceGithubRepositoryRulesetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -552,8 +765,9 @@ func resourceGithubRepositoryRulesetImport(d *schema.ResourceData, meta interfac
 		return []*schema.ResourceData{d}, err
 	}
 	d.Set("repository", *repository.Name)
+	d.Set("force_overwrite", false)
 
-	ruleset, _, err := client.Repositories.GetRuleset(ctx, owner, *repository.Name, rulesetID, false)
+	ruleset, _, _, _, err := getRulesetJSON(ctx, client, fmt.Sprintf("repos/%s/%s/rulesets/%d?includes_parents=false", owner, *repository.Name, rulesetID))
 	if ruleset == nil || err != nil {
 		return []*schema.ResourceData{d}, err
 	}
@@ -0,0 +1,249 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// rulesetBypassActors is the part of a ruleset read and written by
+// github_repository_ruleset_bypass_actor. Updating a ruleset with only this
+// field leaves its other settings unchanged.
+type rulesetBypassActors struct {
+	BypassActors []*github.BypassActor `json:"bypass_actors"`
+}
+
+// rulesetBypassActorMutex serializes changes to the bypass actors of
+// rulesets, as every change reads and writes the whole list.
+var rulesetBypassActorMutex sync.Mutex
+
+func resourceGithubRepositoryRulesetBypassActor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryRulesetBypassActorCreateOrUpdate,
+		Read:   resourceGithubRepositoryRulesetBypassActorRead,
+		Update: resourceGithubRepositoryRulesetBypassActorCreateOrUpdate,
+		Delete: resourceGithubRepositoryRulesetBypassActorDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGithubRepositoryRulesetBypassActorImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository of the ruleset.",
+			},
+			"ruleset_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the ruleset.",
+			},
+			"actor_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the actor that can bypass the ruleset. When `actor_type` is `OrganizationAdmin`, this should be set to `1`.",
+			},
+			"actor_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"RepositoryRole", "Team", "Integration", "OrganizationAdmin"}, false), "actor_type"),
+				Description:      "The type of actor that can bypass the ruleset. Can be one of: `RepositoryRole`, `Team`, `Integration`, `OrganizationAdmin`.",
+			},
+			"bypass_mode": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"always", "pull_request"}, false), "bypass_mode"),
+				Description:      "When the actor can bypass the ruleset. Can be one of: `always`, `pull_request`.",
+			},
+		},
+	}
+}
+
+func resourceGithubRepositoryRulesetBypassActorCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	repoName := d.Get("repository").(string)
+	rulesetID := int64(d.Get("ruleset_id").(int))
+	actor := &github.BypassActor{
+		ActorID:    github.Int64(int64(d.Get("actor_id").(int))),
+		ActorType:  github.String(d.Get("actor_type").(string)),
+		BypassMode: github.String(d.Get("bypass_mode").(string)),
+	}
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	rulesetBypassActorMutex.Lock()
+	defer rulesetBypassActorMutex.Unlock()
+
+	actors, err := getRepositoryRulesetBypassActors(ctx, meta, repoName, rulesetID)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i, existing := range actors {
+		if bypassActorMatches(existing, actor) {
+			actors[i] = actor
+			found = true
+		}
+	}
+	if !found {
+		actors = append(actors, actor)
+	}
+
+	log.Printf("[DEBUG] Setting bypass mode of %s %d on ruleset %s: %d to %s",
+		actor.GetActorType(), actor.GetActorID(), repoName, rulesetID, actor.GetBypassMode())
+	err = updateRepositoryRulesetBypassActors(ctx, meta, repoName, rulesetID, actors)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildThreePartID(repoName, strconv.FormatInt(rulesetID, 10),
+		buildTwoPartID(actor.GetActorType(), strconv.FormatInt(actor.GetActorID(), 10))))
+
+	return resourceGithubRepositoryRulesetBypassActorRead(d, meta)
+}
+
+func resourceGithubRepositoryRulesetBypassActorRead(d *schema.ResourceData, meta interface{}) error {
+	repoName := d.Get("repository").(string)
+	rulesetID := int64(d.Get("ruleset_id").(int))
+	actor := &github.BypassActor{
+		ActorID:   github.Int64(int64(d.Get("actor_id").(int))),
+		ActorType: github.String(d.Get("actor_type").(string)),
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	actors, err := getRepositoryRulesetBypassActors(ctx, meta, repoName, rulesetID)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "bypass actor %s", d.Id())
+	}
+
+	for _, existing := range actors {
+		if bypassActorMatches(existing, actor) {
+			return d.Set("bypass_mode", existing.GetBypassMode())
+		}
+	}
+
+	log.Printf("[INFO] Removing bypass actor %s from state because it is no longer a bypass actor of the ruleset", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceGithubRepositoryRulesetBypassActorDelete(d *schema.ResourceData, meta interface{}) error {
+	repoName := d.Get("repository").(string)
+	rulesetID := int64(d.Get("ruleset_id").(int))
+	actor := &github.BypassActor{
+		ActorID:   github.Int64(int64(d.Get("actor_id").(int))),
+		ActorType: github.String(d.Get("actor_type").(string)),
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	rulesetBypassActorMutex.Lock()
+	defer rulesetBypassActorMutex.Unlock()
+
+	actors, err := getRepositoryRulesetBypassActors(ctx, meta, repoName, rulesetID)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "ruleset %s: %d", repoName, rulesetID)
+	}
+
+	remaining := make([]*github.BypassActor, 0, len(actors))
+	for _, existing := range actors {
+		if !bypassActorMatches(existing, actor) {
+			remaining = append(remaining, existing)
+		}
+	}
+	if len(remaining) == len(actors) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Removing bypass actor %s", d.Id())
+	return updateRepositoryRulesetBypassActors(ctx, meta, repoName, rulesetID, remaining)
+}
+
+func resourceGithubRepositoryRulesetBypassActorImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	repoName, rulesetIDString, actor, err := parseThreePartID(d.Id(), "repository", "ruleset_id", "actor_type:actor_id")
+	if err != nil {
+		return nil, err
+	}
+	actorType, actorIDString, err := parseTwoPartID(actor, "actor_type", "actor_id")
+	if err != nil {
+		return nil, err
+	}
+
+	rulesetID, err := strconv.Atoi(rulesetIDString)
+	if err != nil {
+		return nil, unconvertibleIdErr(rulesetIDString, err)
+	}
+	actorID, err := strconv.Atoi(actorIDString)
+	if err != nil {
+		return nil, unconvertibleIdErr(actorIDString, err)
+	}
+
+	if err = d.Set("repository", repoName); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ruleset_id", rulesetID); err != nil {
+		return nil, err
+	}
+	if err = d.Set("actor_type", actorType); err != nil {
+		return nil, err
+	}
+	if err = d.Set("actor_id", actorID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// bypassActorMatches reports whether both bypass actors refer to the same
+// actor. GitHub doesn't return an ID for the organization admin actor.
+func bypassActorMatches(a, b *github.BypassActor) bool {
+	if a.GetActorType() != b.GetActorType() {
+		return false
+	}
+	return a.GetActorType() == "OrganizationAdmin" || a.GetActorID() == b.GetActorID()
+}
+
+// The generic client is used so that only the bypass actors of the ruleset
+// are sent; go-github always sends the name and enforcement of a ruleset and
+// drops rule parameters it doesn't know about.
+
+func getRepositoryRulesetBypassActors(ctx context.Context, meta interface{}, repoName string, rulesetID int64) ([]*github.BypassActor, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	req, err := client.NewRequest("GET", fmt.Sprintf("repos/%s/%s/rulesets/%d", owner, repoName, rulesetID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleset := new(rulesetBypassActors)
+	_, err = client.Do(ctx, req, ruleset)
+	if err != nil {
+		return nil, err
+	}
+	return ruleset.BypassActors, nil
+}
+
+func updateRepositoryRulesetBypassActors(ctx context.Context, meta interface{}, repoName string, rulesetID int64, actors []*github.BypassActor) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	req, err := client.NewRequest("PUT", fmt.Sprintf("repos/%s/%s/rulesets/%d", owner, repoName, rulesetID), &rulesetBypassActors{BypassActors: actors})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
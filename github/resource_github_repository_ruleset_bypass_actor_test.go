@@ -0,0 +1,127 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubRepositoryRulesetBypassActor(t *testing.T) {
+	ruleset := `{"id": 42, "name": "main", "enforcement": "active", "bypass_actors": [{"actor_id": 1, "actor_type": "OrganizationAdmin", "bypass_mode": "always"}, {"actor_id": 7, "actor_type": "Team", "bypass_mode": "always"}]}`
+
+	newMeta := func(ts string) *Owner {
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts + "/")
+		client.BaseURL = u
+		return &Owner{name: "example", v3client: client, IsOrganization: true}
+	}
+
+	t.Run("adds a bypass actor without touching the others", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/hello-world/rulesets/42",
+				ExpectedMethod: "GET",
+				ResponseBody:   ruleset,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/repos/example/hello-world/rulesets/42",
+				ExpectedMethod: "PUT",
+				ExpectedBody:   []byte(`{"bypass_actors":[{"actor_id":1,"actor_type":"OrganizationAdmin","bypass_mode":"always"},{"actor_id":7,"actor_type":"Team","bypass_mode":"always"},{"actor_id":9,"actor_type":"Team","bypass_mode":"pull_request"}]}` + "\n"),
+				ResponseBody:   ruleset,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/repos/example/hello-world/rulesets/42",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"id": 42, "bypass_actors": [{"actor_id": 9, "actor_type": "Team", "bypass_mode": "pull_request"}]}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryRulesetBypassActor().Schema, map[string]interface{}{
+			"repository":  "hello-world",
+			"ruleset_id":  42,
+			"actor_id":    9,
+			"actor_type":  "Team",
+			"bypass_mode": "pull_request",
+		})
+
+		err := resourceGithubRepositoryRulesetBypassActorCreateOrUpdate(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "hello-world:42:Team:9" {
+			t.Errorf("Unexpected ID %q", d.Id())
+		}
+		if mode := d.Get("bypass_mode").(string); mode != "pull_request" {
+			t.Errorf("Expected bypass mode pull_request, got %q", mode)
+		}
+	})
+
+	t.Run("removes only its own bypass actor", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/hello-world/rulesets/42",
+				ExpectedMethod: "GET",
+				ResponseBody:   ruleset,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/repos/example/hello-world/rulesets/42",
+				ExpectedMethod: "PUT",
+				ExpectedBody:   []byte(`{"bypass_actors":[{"actor_id":1,"actor_type":"OrganizationAdmin","bypass_mode":"always"}]}` + "\n"),
+				ResponseBody:   ruleset,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryRulesetBypassActor().Schema, map[string]interface{}{
+			"repository":  "hello-world",
+			"ruleset_id":  42,
+			"actor_id":    7,
+			"actor_type":  "Team",
+			"bypass_mode": "always",
+		})
+		d.SetId("hello-world:42:Team:7")
+
+		err := resourceGithubRepositoryRulesetBypassActorDelete(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+
+	t.Run("is removed from state once it is no longer a bypass actor", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  "/repos/example/hello-world/rulesets/42",
+				ResponseBody: ruleset,
+				StatusCode:   http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryRulesetBypassActor().Schema, map[string]interface{}{
+			"repository":  "hello-world",
+			"ruleset_id":  42,
+			"actor_id":    9,
+			"actor_type":  "Team",
+			"bypass_mode": "always",
+		})
+		d.SetId("hello-world:42:Team:9")
+
+		err := resourceGithubRepositoryRulesetBypassActorRead(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if d.Id() != "" {
+			t.Errorf("Expected the bypass actor to be removed from state, got ID %q", d.Id())
+		}
+	})
+}
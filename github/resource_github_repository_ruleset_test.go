@@ -1,13 +1,18 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
 	"strings"
 	"testing"
 
+	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -61,6 +66,7 @@ func TestGithubRepositoryRulesets(t *testing.T) {
 						require_code_owner_review         = true
 						dismiss_stale_reviews_on_push     = true
 						require_last_push_approval        = true
+						allowed_merge_methods             = ["squash"]
 					}
 
 					required_status_checks {
@@ -70,6 +76,7 @@ func TestGithubRepositoryRulesets(t *testing.T) {
 						}
 
 						strict_required_status_checks_policy = true
+						do_not_enforce_on_create             = true
 					}
 
 					non_fast_forward = true
@@ -86,6 +93,10 @@ func TestGithubRepositoryRulesets(t *testing.T) {
 				"github_repository_ruleset.test", "enforcement",
 				"active",
 			),
+			resource.TestCheckResourceAttr(
+				"github_repository_ruleset.test", "rules.0.pull_request.0.allowed_merge_methods.#",
+				"1",
+			),
 		)
 
 		testCase := func(t *testing.T, mode string) {
@@ -319,6 +330,7 @@ func TestGithubRepositoryRulesets(t *testing.T) {
 						}
 
 						strict_required_status_checks_policy = true
+						do_not_enforce_on_create             = true
 					}
 
 					non_fast_forward = true
@@ -391,3 +403,235 @@ func importRepositoryRulesetByResourcePaths(repoLogicalName, rulesetLogicalName
 		return fmt.Sprintf("%s:%s", repoID, rulesetID), nil
 	}
 }
+
+func TestCheckRepositoryRulesetUnchanged(t *testing.T) {
+	const etag = `W/"abc"`
+
+	newClient := func(responses []*mockResponse) (*github.Client, func()) {
+		ts := githubApiMock(responses)
+		client := github.NewClient(&http.Client{Transport: NewEtagTransport(http.DefaultTransport)})
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+		return client, ts.Close
+	}
+
+	getRuleset := func(status int) []*mockResponse {
+		return []*mockResponse{
+			{
+				ExpectedUri:     "/repos/example/repo/rulesets/1?includes_parents=false",
+				ExpectedHeaders: map[string]string{"If-None-Match": etag},
+				ResponseBody:    `{"id": 1, "name": "main", "enforcement": "active"}`,
+				StatusCode:      status,
+			},
+		}
+	}
+
+	t.Run("allows updating an unchanged ruleset", func(t *testing.T) {
+		client, done := newClient(getRuleset(http.StatusNotModified))
+		defer done()
+
+		err := checkRepositoryRulesetUnchanged(context.Background(), client, "example", "repo", 1, etag)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+
+	t.Run("refuses to update a ruleset changed since it was read", func(t *testing.T) {
+		client, done := newClient(getRuleset(http.StatusOK))
+		defer done()
+
+		err := checkRepositoryRulesetUnchanged(context.Background(), client, "example", "repo", 1, etag)
+		if err == nil || !strings.Contains(err.Error(), "force_overwrite") {
+			t.Fatalf("Expected a conflict error, got %v", err)
+		}
+	})
+
+	t.Run("skips the check without a known etag", func(t *testing.T) {
+		client, done := newClient(nil)
+		defer done()
+
+		err := checkRepositoryRulesetUnchanged(context.Background(), client, "example", "repo", 1, "")
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+}
+
+func TestRepositoryRulesetCodeScanningRoundTrip(t *testing.T) {
+	rulesetJSON := `{
+  "id": 1,
+  "name": "main",
+  "target": "branch",
+  "enforcement": "active",
+  "rules": [
+    {
+      "type": "code_scanning",
+      "parameters": {
+        "code_scanning_tools": [
+          {"tool": "CodeQL", "alerts_threshold": "errors", "security_alerts_threshold": "high_or_higher"}
+        ]
+      }
+    }
+  ]
+}`
+
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/example/repo/rulesets?includes_parents=false",
+			ExpectedMethod: "GET",
+			ResponseBody:   `[]`,
+			StatusCode:     200,
+		},
+		{
+			ExpectedUri:    "/repos/example/repo/rulesets",
+			ExpectedMethod: "POST",
+			ResponseBody:   rulesetJSON,
+			StatusCode:     201,
+		},
+		{
+			ExpectedUri:    "/repos/example/repo/rulesets/1?includes_parents=false",
+			ExpectedMethod: "GET",
+			ResponseBody:   rulesetJSON,
+			StatusCode:     200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	d := schema.TestResourceDataRaw(t, resourceGithubRepositoryRuleset().Schema, map[string]interface{}{
+		"name":        "main",
+		"repository":  "repo",
+		"target":      "branch",
+		"enforcement": "active",
+		"rules": []interface{}{
+			map[string]interface{}{
+				"code_scanning": []interface{}{
+					map[string]interface{}{
+						"code_scanning_tool": []interface{}{
+							map[string]interface{}{
+								"tool":                      "CodeQL",
+								"alerts_threshold":          "errors",
+								"security_alerts_threshold": "high_or_higher",
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	err := resourceGithubRepositoryRulesetCreate(d, &Owner{name: "example", v3client: client})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	if d.Id() != "1" {
+		t.Errorf("Expected ID 1, got %q", d.Id())
+	}
+	if tool := d.Get("rules.0.code_scanning.0.code_scanning_tool").(*schema.Set).List(); len(tool) != 1 || tool[0].(map[string]interface{})["tool"] != "CodeQL" {
+		t.Errorf("Expected the code_scanning rule to be read back, got %#v", tool)
+	}
+}
+
+func TestRepositoryRulesetRawRules(t *testing.T) {
+	rulesetJSON := `{
+  "id": 1,
+  "name": "main",
+  "target": "push",
+  "enforcement": "active",
+  "rules": [
+    {"type": "max_file_size", "parameters": {"max_file_size": 10}}
+  ]
+}`
+
+	newResourceData := func() *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, resourceGithubRepositoryRuleset().Schema, map[string]interface{}{
+			"name":            "main",
+			"repository":      "repo",
+			"target":          "push",
+			"enforcement":     "active",
+			"force_overwrite": true,
+			"rules":           []interface{}{map[string]interface{}{}},
+			"raw_rules_json":  `[{"type": "max_file_size", "parameters": {"max_file_size": 10}}]`,
+		})
+	}
+
+	newClient := func(responses []*mockResponse) (*github.Client, func()) {
+		ts := githubApiMock(responses)
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+		return client, ts.Close
+	}
+
+	expectRawRules := func(t *testing.T, d *schema.ResourceData) {
+		if d.Id() != "1" {
+			t.Errorf("Expected ID 1, got %q", d.Id())
+		}
+		if rawRules := d.Get("raw_rules_json").(string); rawRules != `[{"type":"max_file_size","parameters":{"max_file_size":10}}]` {
+			t.Errorf("Expected the max_file_size rule to be read back, got %s", rawRules)
+		}
+	}
+
+	t.Run("creates a ruleset with rules go-github can't decode", func(t *testing.T) {
+		client, done := newClient([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo/rulesets?includes_parents=false",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[]`,
+				StatusCode:     200,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/rulesets",
+				ExpectedMethod: "POST",
+				ExpectedBody:   []byte(`{"name":"main","target":"push","source_type":"Repository","source":"repo","enforcement":"active","rules":[{"type":"max_file_size","parameters":{"max_file_size":10},"ruleset_source_type":"","ruleset_source":"","ruleset_id":0}]}` + "\n"),
+				ResponseBody:   rulesetJSON,
+				StatusCode:     201,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/rulesets/1?includes_parents=false",
+				ExpectedMethod: "GET",
+				ResponseBody:   rulesetJSON,
+				StatusCode:     200,
+			},
+		})
+		defer done()
+
+		d := newResourceData()
+		err := resourceGithubRepositoryRulesetCreate(d, &Owner{name: "example", v3client: client})
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		expectRawRules(t, d)
+	})
+
+	t.Run("updates a ruleset with rules go-github can't decode", func(t *testing.T) {
+		client, done := newClient([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo/rulesets/1",
+				ExpectedMethod: "PUT",
+				ExpectedBody:   []byte(`{"name":"main","target":"push","source_type":"Repository","source":"repo","enforcement":"active","rules":[{"type":"max_file_size","parameters":{"max_file_size":10},"ruleset_source_type":"","ruleset_source":"","ruleset_id":0}]}` + "\n"),
+				ResponseBody:   rulesetJSON,
+				StatusCode:     200,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/rulesets/1?includes_parents=false",
+				ExpectedMethod: "GET",
+				ResponseBody:   rulesetJSON,
+				StatusCode:     200,
+			},
+		})
+		defer done()
+
+		d := newResourceData()
+		d.SetId("1")
+		err := resourceGithubRepositoryRulesetUpdate(d, &Owner{name: "example", v3client: client})
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		expectRawRules(t, d)
+	})
+}
@@ -0,0 +1,160 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubRepositorySecurityAndAnalysisStatusSchema(setting, description string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"enabled", "disabled"}, false), setting),
+		Description:      description,
+	}
+}
+
+func resourceGithubRepositorySecurityAndAnalysis() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositorySecurityAndAnalysisCreateOrUpdate,
+		Read:   resourceGithubRepositorySecurityAndAnalysisRead,
+		Update: resourceGithubRepositorySecurityAndAnalysisCreateOrUpdate,
+		Delete: resourceGithubRepositorySecurityAndAnalysisDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"advanced_security": resourceGithubRepositorySecurityAndAnalysisStatusSchema("advanced_security",
+				"Set to 'enabled' to enable advanced security features on the repository. Can be 'enabled' or 'disabled'. Advanced security is always enabled on public repositories, so this setting cannot be supplied for them."),
+			"secret_scanning": resourceGithubRepositorySecurityAndAnalysisStatusSchema("secret_scanning",
+				"Set to 'enabled' to enable secret scanning on the repository. Can be 'enabled' or 'disabled'."),
+			"secret_scanning_push_protection": resourceGithubRepositorySecurityAndAnalysisStatusSchema("secret_scanning_push_protection",
+				"Set to 'enabled' to enable secret scanning push protection on the repository. Can be 'enabled' or 'disabled'."),
+			"dependabot_security_updates": resourceGithubRepositorySecurityAndAnalysisStatusSchema("dependabot_security_updates",
+				"Set to 'enabled' to enable Dependabot security updates on the repository. Can be 'enabled' or 'disabled'."),
+		},
+	}
+}
+
+// securityAndAnalysisStatusToSet returns the status of the setting if it is
+// configured and differs from the state, so that settings which aren't
+// managed by the resource are left untouched.
+func securityAndAnalysisStatusToSet(d *schema.ResourceData, setting string) *string {
+	v, ok := d.GetOk(setting)
+	if !ok || !d.HasChange(setting) {
+		return nil
+	}
+	return github.String(v.(string))
+}
+
+func resourceGithubRepositorySecurityAndAnalysisCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	var securityAndAnalysis github.SecurityAndAnalysis
+	changed := false
+	if status := securityAndAnalysisStatusToSet(d, "advanced_security"); status != nil {
+		securityAndAnalysis.AdvancedSecurity = &github.AdvancedSecurity{Status: status}
+		changed = true
+	}
+	if status := securityAndAnalysisStatusToSet(d, "secret_scanning"); status != nil {
+		securityAndAnalysis.SecretScanning = &github.SecretScanning{Status: status}
+		changed = true
+	}
+	if status := securityAndAnalysisStatusToSet(d, "secret_scanning_push_protection"); status != nil {
+		securityAndAnalysis.SecretScanningPushProtection = &github.SecretScanningPushProtection{Status: status}
+		changed = true
+	}
+
+	if changed {
+		log.Printf("[DEBUG] Updating security and analysis settings of repository %s/%s", owner, repoName)
+		_, _, err := client.Repositories.Edit(ctx, owner, repoName, &github.Repository{
+			SecurityAndAnalysis: &securityAndAnalysis,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Dependabot security updates can't be changed through the repository's
+	// security and analysis settings.
+	if status := securityAndAnalysisStatusToSet(d, "dependabot_security_updates"); status != nil {
+		var err error
+		if *status == "enabled" {
+			_, err = client.Repositories.EnableAutomatedSecurityFixes(ctx, owner, repoName)
+		} else {
+			_, err = client.Repositories.DisableAutomatedSecurityFixes(ctx, owner, repoName)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(repoName)
+
+	return resourceGithubRepositorySecurityAndAnalysisRead(d, meta)
+}
+
+func resourceGithubRepositorySecurityAndAnalysisRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing security and analysis settings of repository %s/%s from state because the repository no longer exists in GitHub",
+					owner, repoName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	securityAndAnalysis := repo.GetSecurityAndAnalysis()
+
+	if err = d.Set("repository", repoName); err != nil {
+		return err
+	}
+	if err = d.Set("advanced_security", securityAndAnalysis.GetAdvancedSecurity().GetStatus()); err != nil {
+		return err
+	}
+	if err = d.Set("secret_scanning", securityAndAnalysis.GetSecretScanning().GetStatus()); err != nil {
+		return err
+	}
+	if err = d.Set("secret_scanning_push_protection", securityAndAnalysis.GetSecretScanningPushProtection().GetStatus()); err != nil {
+		return err
+	}
+	return d.Set("dependabot_security_updates", securityAndAnalysis.GetDependabotSecurityUpdates().GetStatus())
+}
+
+func resourceGithubRepositorySecurityAndAnalysisDelete(d *schema.ResourceData, meta interface{}) error {
+	// Turning security features off when the resource is destroyed could
+	// leave a repository unprotected, so the settings are left in place.
+	log.Printf("[INFO] Removing security and analysis settings of repository %s/%s from state, the settings are left in place",
+		meta.(*Owner).name, d.Id())
+	return nil
+}
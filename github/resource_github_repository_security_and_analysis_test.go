@@ -0,0 +1,68 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubRepositorySecurityAndAnalysis(t *testing.T) {
+	t.Run("only changes the configured settings", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo",
+				ExpectedMethod: "PATCH",
+				ExpectedBody:   []byte(`{"security_and_analysis":{"secret_scanning":{"status":"enabled"}}}` + "\n"),
+				ResponseBody:   `{"name": "repo"}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/automated-security-fixes",
+				ExpectedMethod: "PUT",
+				StatusCode:     http.StatusNoContent,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo",
+				ExpectedMethod: "GET",
+				ResponseBody: `{"name": "repo", "security_and_analysis": {
+					"advanced_security": {"status": "enabled"},
+					"secret_scanning": {"status": "enabled"},
+					"secret_scanning_push_protection": {"status": "disabled"},
+					"dependabot_security_updates": {"status": "enabled"}
+				}}`,
+				StatusCode: http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client, IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositorySecurityAndAnalysis().Schema, map[string]interface{}{
+			"repository":                  "repo",
+			"secret_scanning":             "enabled",
+			"dependabot_security_updates": "enabled",
+		})
+
+		err := resourceGithubRepositorySecurityAndAnalysisCreateOrUpdate(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if d.Id() != "repo" {
+			t.Errorf("Unexpected ID %q", d.Id())
+		}
+		if v := d.Get("advanced_security").(string); v != "enabled" {
+			t.Errorf("Unexpected advanced_security %q", v)
+		}
+		if v := d.Get("secret_scanning_push_protection").(string); v != "disabled" {
+			t.Errorf("Unexpected secret_scanning_push_protection %q", v)
+		}
+	})
+}
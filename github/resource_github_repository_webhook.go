@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,11 +29,19 @@ func resourceGithubRepositoryWebhook() *schema.Resource {
 				if err := d.Set("repository", parts[0]); err != nil {
 					return nil, err
 				}
+				if err := d.Set("deliver_test_payload", false); err != nil {
+					return nil, err
+				}
 				d.SetId(parts[1])
 				return []*schema.ResourceData{d}, nil
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+		},
+
 		SchemaVersion: 1,
 		MigrateState:  resourceGithubWebhookMigrateState,
 
@@ -61,6 +71,12 @@ func resourceGithubRepositoryWebhook() *schema.Resource {
 				Default:     true,
 				Description: "Indicate if the webhook should receive events. Defaults to 'true'.",
 			},
+			"deliver_test_payload": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Ping the webhook after it is created or updated, and fail if the ping is not delivered successfully. Only active webhooks are pinged.",
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -117,6 +133,13 @@ func resourceGithubRepositoryWebhookCreate(d *schema.ResourceData, meta interfac
 		return err
 	}
 
+	if d.Get("deliver_test_payload").(bool) && hook.GetActive() {
+		err = pingRepositoryWebhook(ctx, meta, repoName, hook.GetID(), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceGithubRepositoryWebhookRead(d, meta)
 }
 
@@ -190,11 +213,18 @@ func resourceGithubRepositoryWebhookUpdate(d *schema.ResourceData, meta interfac
 	}
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
-	_, _, err = client.Repositories.EditHook(ctx, owner, repoName, hookID, hk)
+	hook, _, err := client.Repositories.EditHook(ctx, owner, repoName, hookID, hk)
 	if err != nil {
 		return err
 	}
 
+	if d.Get("deliver_test_payload").(bool) && hook.GetActive() {
+		err = pingRepositoryWebhook(ctx, meta, repoName, hookID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceGithubRepositoryWebhookRead(d, meta)
 }
 
@@ -212,3 +242,48 @@ func resourceGithubRepositoryWebhookDelete(d *schema.ResourceData, meta interfac
 	_, err = client.Repositories.DeleteHook(ctx, owner, repoName, hookID)
 	return err
 }
+
+// pingRepositoryWebhook sends a ping event to the webhook and waits for its
+// delivery, so that a webhook with an unreachable or failing endpoint is
+// reported right away.
+func pingRepositoryWebhook(ctx context.Context, meta interface{}, repoName string, hookID int64, timeout time.Duration) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	// Deliveries are listed most recent first, so remember the latest one to
+	// tell the delivery of this ping apart from earlier ones.
+	previous, _, err := client.Repositories.ListHookDeliveries(ctx, owner, repoName, hookID, &github.ListCursorOptions{PerPage: 1})
+	if err != nil {
+		return err
+	}
+	var latestID int64
+	if len(previous) > 0 {
+		latestID = previous[0].GetID()
+	}
+
+	log.Printf("[DEBUG] Pinging repository webhook %s/%s/%d", owner, repoName, hookID)
+	_, err = client.Repositories.PingHook(ctx, owner, repoName, hookID)
+	if err != nil {
+		return err
+	}
+
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		deliveries, _, err := client.Repositories.ListHookDeliveries(ctx, owner, repoName, hookID, &github.ListCursorOptions{PerPage: 10})
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		for _, delivery := range deliveries {
+			if delivery.GetID() <= latestID || delivery.GetEvent() != "ping" {
+				continue
+			}
+			if code := delivery.GetStatusCode(); code < 200 || code >= 300 {
+				return retry.NonRetryableError(fmt.Errorf("ping of repository webhook %s/%s/%d failed: %s (status code %d)",
+					owner, repoName, hookID, delivery.GetStatus(), code))
+			}
+			return nil
+		}
+
+		return retry.RetryableError(fmt.Errorf("ping of repository webhook %s/%s/%d has not been delivered yet", owner, repoName, hookID))
+	})
+}
@@ -1,9 +1,15 @@
 package github
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -208,3 +214,48 @@ func TestAccGithubRepositoryWebhook(t *testing.T) {
 		})
 	})
 }
+
+func TestPingRepositoryWebhook(t *testing.T) {
+	testCase := func(t *testing.T, pingDelivery string) error {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  "/repos/example/hello-world/hooks/1/deliveries?per_page=1",
+				ResponseBody: `[{"id": 10, "event": "push", "status_code": 200}]`,
+				StatusCode:   http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/repos/example/hello-world/hooks/1/pings",
+				ExpectedMethod: "POST",
+				StatusCode:     http.StatusNoContent,
+			},
+			{
+				ExpectedUri:  "/repos/example/hello-world/hooks/1/deliveries?per_page=10",
+				ResponseBody: `[` + pingDelivery + `, {"id": 10, "event": "push", "status_code": 200}]`,
+				StatusCode:   http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", v3client: client}
+
+		return pingRepositoryWebhook(context.Background(), meta, "hello-world", 1, time.Second)
+	}
+
+	t.Run("succeeds when the ping is delivered", func(t *testing.T) {
+		err := testCase(t, `{"id": 11, "event": "ping", "status": "OK", "status_code": 200}`)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+
+	t.Run("fails when the endpoint rejects the ping", func(t *testing.T) {
+		err := testCase(t, `{"id": 11, "event": "ping", "status": "Invalid HTTP Response: 404", "status_code": 404}`)
+		if err == nil || !strings.Contains(err.Error(), "status code 404") {
+			t.Fatalf("Expected the ping to fail with status code 404, got %v", err)
+		}
+	})
+}
@@ -99,6 +99,11 @@ func resourceGithubTeam() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"external_group_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the external group the team is connected to, if the organization uses Enterprise Managed Users.",
+			},
 		},
 	}
 }
@@ -256,7 +261,35 @@ func resourceGithubTeamRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	return nil
+	return readTeamExternalGroup(d, meta, team.GetSlug())
+}
+
+// readTeamExternalGroup sets the ID of the external group the team is
+// connected to. Organizations without Enterprise Managed Users have no
+// external groups, so nothing is requested for their teams.
+func readTeamExternalGroup(d *schema.ResourceData, meta interface{}, slug string) error {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	usesExternalGroups, err := organizationUsesExternalGroups(ctx, meta)
+	if err != nil {
+		return err
+	}
+	if !usesExternalGroups {
+		return d.Set("external_group_id", 0)
+	}
+
+	externalGroupList, _, err := client.Teams.ListExternalGroupsForTeamBySlug(ctx, orgName, slug)
+	if err != nil {
+		return err
+	}
+
+	var externalGroupID int64
+	if len(externalGroupList.Groups) > 0 {
+		externalGroupID = externalGroupList.Groups[0].GetGroupID()
+	}
+	return d.Set("external_group_id", externalGroupID)
 }
 
 func resourceGithubTeamUpdate(d *schema.ResourceData, meta interface{}) error {
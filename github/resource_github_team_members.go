@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"log"
 	"reflect"
 	"strconv"
@@ -10,6 +11,7 @@ import (
 	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/shurcooL/githubv4"
+	"golang.org/x/sync/errgroup"
 )
 
 type MemberChange struct {
@@ -61,9 +63,6 @@ func resourceGithubTeamMembers() *schema.Resource {
 }
 
 func resourceGithubTeamMembersCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*Owner).v3client
-	orgId := meta.(*Owner).id
-
 	teamIdString := d.Get("team_id").(string)
 	teamId, err := getTeamID(teamIdString, meta)
 	if err != nil {
@@ -71,24 +70,20 @@ func resourceGithubTeamMembersCreate(d *schema.ResourceData, meta interface{}) e
 	}
 	ctx := context.Background()
 
+	changes := make([]teamMembershipChange, 0)
 	members := d.Get("members").(*schema.Set)
 	for _, mMap := range members.List() {
 		memb := mMap.(map[string]interface{})
-		username := memb["username"].(string)
-		role := memb["role"].(string)
+		changes = append(changes, teamMembershipChange{
+			username: memb["username"].(string),
+			role:     memb["role"].(string),
+			create:   true,
+		})
+	}
 
-		log.Printf("[DEBUG] Creating team membership: %s/%s (%s)", teamIdString, username, role)
-		_, _, err = client.Teams.AddTeamMembershipByID(ctx,
-			orgId,
-			teamId,
-			username,
-			&github.TeamAddTeamMembershipOptions{
-				Role: role,
-			},
-		)
-		if err != nil {
-			return err
-		}
+	err = applyTeamMembershipChanges(ctx, meta, teamId, teamIdString, changes)
+	if err != nil {
+		return err
 	}
 
 	d.SetId(teamIdString)
@@ -97,9 +92,6 @@ func resourceGithubTeamMembersCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGithubTeamMembersUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*Owner).v3client
-	orgId := meta.(*Owner).id
-
 	teamIdString := d.Get("team_id").(string)
 	teamId, err := getTeamID(teamIdString, meta)
 	if err != nil {
@@ -123,50 +115,35 @@ func resourceGithubTeamMembersUpdate(d *schema.ResourceData, meta interface{}) e
 		vals[k].New = obj
 	}
 
+	changes := make([]teamMembershipChange, 0)
 	for username, change := range vals {
-		var create, delete bool
+		c := teamMembershipChange{username: username}
 
 		switch {
 		// create a new one if old is nil
 		case change.Old == nil:
-			create = true
+			c.create = true
 		// delete existing if new is nil
 		case change.New == nil:
-			delete = true
+			c.delete = true
 			// no change
 		case reflect.DeepEqual(change.Old, change.New):
 			continue
-			// recreate - role changed
+			// update in place - role changed. Adding an existing member changes
+			// their role without dropping their access in between.
 		default:
-			delete = true
-			create = true
+			c.create = true
 		}
 
-		if delete {
-			log.Printf("[DEBUG] Deleting team membership: %s/%s", teamIdString, username)
-
-			_, err = client.Teams.RemoveTeamMembershipByID(ctx, orgId, teamId, username)
-			if err != nil {
-				return err
-			}
+		if c.create {
+			c.role = change.New["role"].(string)
 		}
+		changes = append(changes, c)
+	}
 
-		if create {
-			role := change.New["role"].(string)
-
-			log.Printf("[DEBUG] Creating team membership: %s/%s (%s)", teamIdString, username, role)
-			_, _, err = client.Teams.AddTeamMembershipByID(ctx,
-				orgId,
-				teamId,
-				username,
-				&github.TeamAddTeamMembershipOptions{
-					Role: role,
-				},
-			)
-			if err != nil {
-				return err
-			}
-		}
+	err = applyTeamMembershipChanges(ctx, meta, teamId, teamIdString, changes)
+	if err != nil {
+		return recordPartialUpdate(err, d, meta, resourceGithubTeamMembersRead)
 	}
 
 	d.SetId(teamIdString)
@@ -174,6 +151,73 @@ func resourceGithubTeamMembersUpdate(d *schema.ResourceData, meta interface{}) e
 	return resourceGithubTeamMembersRead(d, meta)
 }
 
+// maxTeamMembershipWorkers bounds the number of team membership changes that
+// are applied concurrently when parallel_requests is enabled. Otherwise the
+// rate limit transport serializes the requests, so they are applied one at a
+// time.
+const maxTeamMembershipWorkers = 10
+
+type teamMembershipChange struct {
+	username string
+	role     string
+	delete   bool
+	create   bool
+}
+
+// applyTeamMembershipChanges applies the given changes and returns the errors
+// of all changes that failed.
+func applyTeamMembershipChanges(ctx context.Context, meta interface{}, teamId int64, teamIdString string, changes []teamMembershipChange) error {
+	var g errgroup.Group
+	g.SetLimit(1)
+	if meta.(*Owner).parallelRequests {
+		g.SetLimit(maxTeamMembershipWorkers)
+	}
+
+	errs := make([]error, len(changes))
+	for i, change := range changes {
+		i, change := i, change
+		g.Go(func() error {
+			errs[i] = applyTeamMembershipChange(ctx, meta, teamId, teamIdString, change)
+			return nil
+		})
+	}
+	_ = g.Wait()
+	teamMembershipRosters.invalidate(teamId)
+
+	return errors.Join(errs...)
+}
+
+func applyTeamMembershipChange(ctx context.Context, meta interface{}, teamId int64, teamIdString string, change teamMembershipChange) error {
+	client := meta.(*Owner).v3client
+	orgId := meta.(*Owner).id
+
+	if change.delete {
+		log.Printf("[DEBUG] Deleting team membership: %s/%s", teamIdString, change.username)
+
+		_, err := client.Teams.RemoveTeamMembershipByID(ctx, orgId, teamId, change.username)
+		if err != nil {
+			return err
+		}
+	}
+
+	if change.create {
+		log.Printf("[DEBUG] Creating team membership: %s/%s (%s)", teamIdString, change.username, change.role)
+		_, _, err := client.Teams.AddTeamMembershipByID(ctx,
+			orgId,
+			teamId,
+			change.username,
+			&github.TeamAddTeamMembershipOptions{
+				Role: change.role,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceGithubTeamMembersRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Owner).v4client
 	orgName := meta.(*Owner).name
@@ -268,6 +312,7 @@ func resourceGithubTeamMembersDelete(d *schema.ResourceData, meta interface{}) e
 		log.Printf("[DEBUG] Deleting team membership: %s/%s", teamIdString, username)
 
 		_, err = client.Teams.RemoveTeamMembershipByID(ctx, orgId, teamId, username)
+		teamMembershipRosters.invalidate(teamId)
 		if err != nil {
 			return err
 		}
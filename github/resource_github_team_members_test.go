@@ -3,8 +3,13 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -210,3 +215,81 @@ resource "github_team_members" "test_team_members" {
 }
 `, username, randString, username, role)
 }
+
+func TestApplyTeamMembershipChanges(t *testing.T) {
+	// apply applies changes for 20 users against a server that holds every
+	// request for a while, and returns the most requests it saw in flight.
+	apply := func(t *testing.T, parallelRequests bool) int32 {
+		var inFlight, maxInFlight int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			if r.Method == http.MethodDelete {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			fmt.Fprint(w, `{"state": "active", "role": "member"}`)
+		}))
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+		meta := &Owner{name: "example", id: 1, v3client: client, parallelRequests: parallelRequests}
+
+		changes := make([]teamMembershipChange, 0, 20)
+		for i := 0; i < 20; i++ {
+			changes = append(changes, teamMembershipChange{username: fmt.Sprintf("user%d", i), role: "member", create: i%2 == 0, delete: i%2 == 1})
+		}
+
+		err := applyTeamMembershipChanges(context.Background(), meta, 2, "2", changes)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		return atomic.LoadInt32(&maxInFlight)
+	}
+
+	t.Run("applies changes concurrently with parallel_requests", func(t *testing.T) {
+		if n := apply(t, true); n < 2 || n > maxTeamMembershipWorkers {
+			t.Fatalf("Expected between 2 and %d requests in flight, got %d", maxTeamMembershipWorkers, n)
+		}
+	})
+
+	t.Run("applies changes one at a time without parallel_requests", func(t *testing.T) {
+		if n := apply(t, false); n != 1 {
+			t.Fatalf("Expected 1 request in flight, got %d", n)
+		}
+	})
+
+	t.Run("returns the errors of all failed changes", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message": "Validation Failed"}`)
+		}))
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+		meta := &Owner{name: "example", id: 1, v3client: client, parallelRequests: true}
+
+		changes := []teamMembershipChange{
+			{username: "user0", role: "member", create: true},
+			{username: "user1", role: "member", create: true},
+		}
+		err := applyTeamMembershipChanges(context.Background(), meta, 2, "2", changes)
+		if err == nil || len(err.(interface{ Unwrap() []error }).Unwrap()) != 2 {
+			t.Fatalf("Expected 2 errors, got %v", err)
+		}
+	})
+}
@@ -5,6 +5,9 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -88,6 +91,7 @@ func resourceGithubTeamMembershipCreateOrUpdate(d *schema.ResourceData, meta int
 	if err != nil {
 		return err
 	}
+	teamMembershipRosters.invalidate(teamId)
 
 	d.SetId(buildTwoPartID(teamIdString, username))
 
@@ -119,7 +123,25 @@ func resourceGithubTeamMembershipRead(d *schema.ResourceData, meta interface{})
 
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
+		role, listed, err := teamMembershipRosters.role(ctx, meta, teamId, username)
+		if err != nil {
+			return err
+		}
+		if role != "" {
+			// The etag of the membership doesn't describe the role read from
+			// the roster, so it must not be used for the next read.
+			if err = d.Set("etag", ""); err != nil {
+				return err
+			}
+			return d.Set("role", role)
+		}
+
+		// A user missing from the roster was either removed from the team
+		// or has a pending invitation, which the membership itself tells
+		// apart, so it is read without the etag.
+		if !listed {
+			ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
+		}
 	}
 
 	membership, resp, err := client.Teams.GetTeamMembershipByID(ctx,
@@ -161,6 +183,139 @@ func resourceGithubTeamMembershipDelete(d *schema.ResourceData, meta interface{}
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
 	_, err = client.Teams.RemoveTeamMembershipByID(ctx, orgId, teamId, username)
+	teamMembershipRosters.invalidate(teamId)
 
 	return err
 }
+
+// teamMembershipRosters batches the reads of memberships of the same team.
+// Once more than one membership of a team is refreshed, the members of the
+// team are listed in pages of 100 instead of being read one by one, so plans
+// with thousands of memberships don't make a request per membership.
+var teamMembershipRosters = &teamMembershipRosterCache{teams: make(map[int64]*teamMembershipRoster)}
+
+// teamMembershipRosterTTL bounds how long a listed roster is used, so that
+// changes made outside of Terraform during a long run are picked up.
+const teamMembershipRosterTTL = time.Minute
+
+type teamMembershipRosterCache struct {
+	// The mutex only guards the map and the fields of the rosters, it is
+	// never held while listing members.
+	sync.Mutex
+	teams map[int64]*teamMembershipRoster
+}
+
+type teamMembershipRoster struct {
+	// listing serializes the listing of the members of this team, so
+	// concurrent reads of the same team list it once while other teams are
+	// listed in parallel.
+	listing sync.Mutex
+
+	reads int
+	// generation is incremented whenever the roster is invalidated, so that a
+	// listing started before a write isn't stored after it.
+	generation int
+	listedAt   time.Time
+	// roles maps the lowercased login of every active member of the team to
+	// their role, once the members were listed.
+	roles map[string]string
+}
+
+// role returns the role of the user within the team, or an empty string if
+// the membership has to be read on its own. listed reports whether the members
+// of the team were listed, in which case an empty role means that the user is
+// not an active member of the team.
+func (c *teamMembershipRosterCache) role(ctx context.Context, meta interface{}, teamId int64, username string) (string, bool, error) {
+	c.Lock()
+	roster, ok := c.teams[teamId]
+	if !ok {
+		roster = &teamMembershipRoster{}
+		c.teams[teamId] = roster
+	}
+	roster.reads++
+	reads := roster.reads
+	c.Unlock()
+
+	if reads < 2 {
+		return "", false, nil
+	}
+
+	roster.listing.Lock()
+	defer roster.listing.Unlock()
+
+	c.Lock()
+	roles := roster.roles
+	if time.Since(roster.listedAt) > teamMembershipRosterTTL {
+		roles = nil
+	}
+	generation := roster.generation
+	c.Unlock()
+
+	if roles == nil {
+		var err error
+		roles, err = listTeamMembershipRoles(ctx, meta, teamId)
+		if err != nil {
+			return "", false, err
+		}
+
+		c.Lock()
+		if roster.generation == generation {
+			roster.roles = roles
+			roster.listedAt = time.Now()
+		}
+		c.Unlock()
+	}
+
+	return roles[strings.ToLower(username)], true, nil
+}
+
+// invalidate drops the listed members of the team, which must be called after
+// every change to the memberships of the team.
+func (c *teamMembershipRosterCache) invalidate(teamId int64) {
+	c.Lock()
+	defer c.Unlock()
+
+	if roster, ok := c.teams[teamId]; ok {
+		roster.generation++
+		roster.roles = nil
+	}
+}
+
+func listTeamMembershipRoles(ctx context.Context, meta interface{}, teamId int64) (map[string]string, error) {
+	client := meta.(*Owner).v3client
+	orgId := meta.(*Owner).id
+
+	log.Printf("[DEBUG] Listing members of team %d", teamId)
+	roles := make(map[string]string)
+	// Maintainers are listed first, so that listing all members afterwards
+	// only fills in the remaining members.
+	for _, role := range []string{"maintainer", "all"} {
+		options := &github.TeamListTeamMembersOptions{
+			Role:        role,
+			ListOptions: github.ListOptions{PerPage: maxPerPage},
+		}
+		for {
+			members, resp, err := client.Teams.ListTeamMembersByID(ctx, orgId, teamId, options)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, member := range members {
+				login := strings.ToLower(member.GetLogin())
+				if _, ok := roles[login]; !ok {
+					roles[login] = "member"
+					if role == "maintainer" {
+						roles[login] = role
+					}
+				}
+			}
+
+			if resp.NextPage == 0 {
+				break
+			}
+			options.Page = resp.NextPage
+		}
+	}
+
+	return roles, nil
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strconv"
 	"testing"
 
 	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -64,6 +67,193 @@ func TestAccGithubTeamMembership_basic(t *testing.T) {
 	})
 }
 
+func TestResourceGithubTeamMembershipRead(t *testing.T) {
+	t.Run("lists the team members once several memberships are read", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/organizations/1/team/4711/memberships/alice",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"role": "member", "state": "active"}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/organizations/1/team/4711/members?per_page=100&role=maintainer",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"login": "Bob"}]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/organizations/1/team/4711/members?per_page=100&role=all",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"login": "alice"}, {"login": "Bob"}, {"login": "carol"}]`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", id: 1, v3client: client, IsOrganization: true}
+
+		memberships := []struct{ username, role string }{
+			{"alice", "member"},
+			{"bob", "maintainer"},
+			{"carol", "member"},
+		}
+		for _, membership := range memberships {
+			d := schema.TestResourceDataRaw(t, resourceGithubTeamMembership().Schema, map[string]interface{}{
+				"team_id":  "4711",
+				"username": membership.username,
+			})
+			d.SetId(buildTwoPartID("4711", membership.username))
+
+			err := resourceGithubTeamMembershipRead(d, meta)
+			if err != nil {
+				t.Fatalf("Expected no error reading %s, got %s", membership.username, err)
+			}
+
+			if role := d.Get("role").(string); role != membership.role {
+				t.Errorf("Expected %s to be %s, got %s", membership.username, membership.role, role)
+			}
+		}
+	})
+
+	t.Run("removes a membership missing from the listed members", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/organizations/1/team/4712/memberships/alice",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"role": "member", "state": "active"}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/organizations/1/team/4712/members?per_page=100&role=maintainer",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/organizations/1/team/4712/members?per_page=100&role=all",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"login": "alice"}]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:     "/organizations/1/team/4712/memberships/bob",
+				ExpectedMethod:  "GET",
+				ExpectedHeaders: map[string]string{"If-None-Match": ""},
+				ResponseBody:    `{"message": "Not Found"}`,
+				StatusCode:      http.StatusNotFound,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(&http.Client{Transport: NewEtagTransport(http.DefaultTransport)})
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", id: 1, v3client: client, IsOrganization: true}
+
+		for _, username := range []string{"alice", "bob"} {
+			d := schema.TestResourceDataRaw(t, resourceGithubTeamMembership().Schema, map[string]interface{}{
+				"team_id":  "4712",
+				"username": username,
+				"etag":     `W/"abc"`,
+			})
+			d.SetId(buildTwoPartID("4712", username))
+
+			err := resourceGithubTeamMembershipRead(d, meta)
+			if err != nil {
+				t.Fatalf("Expected no error reading %s, got %s", username, err)
+			}
+
+			if username == "alice" && d.Id() == "" {
+				t.Errorf("Expected alice to remain in state")
+			}
+			if username == "bob" && d.Id() != "" {
+				t.Errorf("Expected bob to be removed from state, got ID %q", d.Id())
+			}
+		}
+	})
+
+	t.Run("lists the team members again after github_team_members changed a role", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/organizations/1/team/4713/memberships/alice",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"role": "member", "state": "active"}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/organizations/1/team/4713/members?per_page=100&role=maintainer",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/organizations/1/team/4713/members?per_page=100&role=all",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"login": "alice"}, {"login": "bob"}]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/organizations/1/team/4713/memberships/bob",
+				ExpectedMethod: "PUT",
+				ResponseBody:   `{"role": "maintainer", "state": "active"}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/organizations/1/team/4713/members?per_page=100&role=maintainer",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"login": "bob"}]`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/organizations/1/team/4713/members?per_page=100&role=all",
+				ExpectedMethod: "GET",
+				ResponseBody:   `[{"login": "alice"}, {"login": "bob"}]`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "example", id: 1, v3client: client, IsOrganization: true}
+
+		read := func(username, expectedRole string) {
+			d := schema.TestResourceDataRaw(t, resourceGithubTeamMembership().Schema, map[string]interface{}{
+				"team_id":  "4713",
+				"username": username,
+			})
+			d.SetId(buildTwoPartID("4713", username))
+
+			err := resourceGithubTeamMembershipRead(d, meta)
+			if err != nil {
+				t.Fatalf("Expected no error reading %s, got %s", username, err)
+			}
+			if role := d.Get("role").(string); role != expectedRole {
+				t.Errorf("Expected %s to be %s, got %s", username, expectedRole, role)
+			}
+		}
+
+		read("alice", "member")
+		read("bob", "member")
+
+		changes := []teamMembershipChange{{username: "bob", role: "maintainer", create: true}}
+		err := applyTeamMembershipChanges(context.Background(), meta, 4713, "4713", changes)
+		if err != nil {
+			t.Fatalf("Expected no error changing the role, got %s", err)
+		}
+
+		read("bob", "maintainer")
+	})
+}
+
 func TestAccGithubTeamMembership_caseInsensitive(t *testing.T) {
 	if testCollaborator == "" {
 		t.Skip("Skipping because `GITHUB_TEST_COLLABORATOR` is not set")
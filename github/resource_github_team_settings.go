@@ -4,12 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/shurcooL/githubv4"
 )
 
+// The notification setting of teams isn't returned by go-github yet.
+type teamNotificationSetting struct {
+	NotificationSetting string `json:"notification_setting,omitempty"`
+}
+
 func resourceGithubTeamSettings() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGithubTeamSettingsCreate,
@@ -86,6 +93,15 @@ func resourceGithubTeamSettings() *schema.Resource {
 					},
 				},
 			},
+			"notification_setting": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+					"notifications_enabled", "notifications_disabled",
+				}, false), "notification_setting"),
+				Description: "Whether team members receive notifications when the team is @mentioned. Can be 'notifications_enabled' or 'notifications_disabled'.",
+			},
 		},
 	}
 }
@@ -153,39 +169,47 @@ func resourceGithubTeamSettingsRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	return nil
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	notificationSetting, err := getTeamNotificationSetting(ctx, meta, teamSlug)
+	if err != nil {
+		return err
+	}
+	return d.Set("notification_setting", notificationSetting)
 
 }
 
 func resourceGithubTeamSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
 	if d.HasChange("review_request_delegation") || d.IsNewResource() {
 
-		ctx := context.WithValue(context.Background(), ctxId, d.Id())
 		graphql := meta.(*Owner).v4client
-		if setting := d.Get("review_request_delegation").([]interface{}); len(setting) == 0 {
-			var mutation struct {
-				UpdateTeamReviewAssignment struct {
-					ClientMutationId githubv4.ID `graphql:"clientMutationId"`
-				} `graphql:"updateTeamReviewAssignment(input:$input)"`
-			}
-
-			return graphql.Mutate(ctx, &mutation, defaultTeamReviewAssignmentSettings(d.Id()), nil)
-		} else {
-			settings := d.Get("review_request_delegation").([]interface{})[0].(map[string]interface{})
-
-			var mutation struct {
-				UpdateTeamReviewAssignment struct {
-					ClientMutationId githubv4.ID `graphql:"clientMutationId"`
-				} `graphql:"updateTeamReviewAssignment(input:$input)"`
-			}
+		var mutation struct {
+			UpdateTeamReviewAssignment struct {
+				ClientMutationId githubv4.ID `graphql:"clientMutationId"`
+			} `graphql:"updateTeamReviewAssignment(input:$input)"`
+		}
 
-			return graphql.Mutate(ctx, &mutation, UpdateTeamReviewAssignmentInput{
+		input := defaultTeamReviewAssignmentSettings(d.Id())
+		if setting := d.Get("review_request_delegation").([]interface{}); len(setting) > 0 {
+			settings := setting[0].(map[string]interface{})
+			input = UpdateTeamReviewAssignmentInput{
 				TeamID:                           d.Id(),
 				ReviewRequestDelegation:          true,
 				ReviewRequestDelegationAlgorithm: settings["algorithm"].(string),
 				ReviewRequestDelegationCount:     settings["member_count"].(int),
 				ReviewRequestDelegationNotifyAll: settings["notify"].(bool),
-			}, nil)
+			}
+		}
+
+		if err := graphql.Mutate(ctx, &mutation, input, nil); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("notification_setting"); ok && d.HasChange("notification_setting") {
+		if err := setTeamNotificationSetting(ctx, meta, d.Get("team_slug").(string), v.(string)); err != nil {
+			return err
 		}
 	}
 
@@ -255,6 +279,39 @@ func resolveTeamIDs(idOrSlug string, meta *Owner, ctx context.Context) (nodeId s
 	}
 }
 
+func getTeamNotificationSetting(ctx context.Context, meta interface{}, teamSlug string) (string, error) {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	req, err := client.NewRequest("GET", fmt.Sprintf("orgs/%s/teams/%s", orgName, teamSlug), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var team teamNotificationSetting
+	_, err = client.Do(ctx, req, &team)
+	if err != nil {
+		return "", err
+	}
+	return team.NotificationSetting, nil
+}
+
+func setTeamNotificationSetting(ctx context.Context, meta interface{}, teamSlug, notificationSetting string) error {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("orgs/%s/teams/%s", orgName, teamSlug), &teamNotificationSetting{
+		NotificationSetting: notificationSetting,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Setting notification setting of team %s/%s to %s", orgName, teamSlug, notificationSetting)
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
+
 type UpdateTeamReviewAssignmentInput struct {
 	ClientMutationID                 string `json:"clientMutationId,omitempty"`
 	TeamID                           string `graphql:"id" json:"id"`
@@ -1,11 +1,15 @@
 package github
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -214,3 +218,42 @@ func TestCannotUseReviewSettingsIfDisabled(t *testing.T) {
 	})
 
 }
+
+func TestTeamNotificationSetting(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/orgs/example/teams/team",
+			ExpectedMethod: "PATCH",
+			ExpectedBody:   []byte(`{"notification_setting":"notifications_disabled"}` + "\n"),
+			ResponseBody:   `{"slug": "team", "notification_setting": "notifications_disabled"}`,
+			StatusCode:     http.StatusOK,
+		},
+		{
+			ExpectedUri:    "/orgs/example/teams/team",
+			ExpectedMethod: "GET",
+			ResponseBody:   `{"slug": "team", "notification_setting": "notifications_disabled"}`,
+			StatusCode:     http.StatusOK,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	meta := &Owner{name: "example", v3client: client}
+	ctx := context.Background()
+
+	err := setTeamNotificationSetting(ctx, meta, "team", "notifications_disabled")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	notificationSetting, err := getTeamNotificationSetting(ctx, meta, "team")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if notificationSetting != "notifications_disabled" {
+		t.Errorf("Unexpected notification setting %q", notificationSetting)
+	}
+}
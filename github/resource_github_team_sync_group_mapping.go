@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
+	"sync"
 
 	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,13 +38,13 @@ func resourceGithubTeamSyncGroupMapping() *schema.Resource {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				ForceNew:    true,
-				Description: "An Array of GitHub Identity Provider Groups (or empty []).",
+				Description: "An Array of GitHub Identity Provider Groups (or empty []). Organizations using external groups can only connect a single group.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"group_id": {
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "The ID of the IdP group.",
+							Description: "The ID of the IdP group. For organizations using external groups, the numeric ID of the external group.",
 						},
 						"group_name": {
 							Type:        schema.TypeString,
@@ -51,8 +53,8 @@ func resourceGithubTeamSyncGroupMapping() *schema.Resource {
 						},
 						"group_description": {
 							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The description of the IdP group.",
+							Optional:    true,
+							Description: "The description of the IdP group. External groups have no description.",
 						},
 					},
 				},
@@ -71,13 +73,10 @@ func resourceGithubTeamSyncGroupMappingCreate(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	client := meta.(*Owner).v3client
 	ctx := context.Background()
-	orgName := meta.(*Owner).name
 	slug := d.Get("team_slug").(string)
 
-	idpGroupList := expandTeamSyncGroups(d)
-	_, _, err = client.Teams.CreateOrUpdateIDPGroupConnectionsBySlug(ctx, orgName, slug, *idpGroupList)
+	err = updateTeamSyncGroupMapping(ctx, d, meta)
 	if err != nil {
 		return err
 	}
@@ -97,12 +96,24 @@ func resourceGithubTeamSyncGroupMappingRead(d *schema.ResourceData, meta interfa
 	orgName := meta.(*Owner).name
 	slug := d.Get("team_slug").(string)
 
+	usesExternalGroups, err := organizationUsesExternalGroups(context.Background(), meta)
+	if err != nil {
+		return err
+	}
+
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
 
-	idpGroupList, resp, err := client.Teams.ListIDPGroupsForTeamBySlug(ctx, orgName, slug)
+	var externalGroupList *github.ExternalGroupList
+	var idpGroupList *github.IDPGroupList
+	var resp *github.Response
+	if usesExternalGroups {
+		externalGroupList, resp, err = client.Teams.ListExternalGroupsForTeamBySlug(ctx, orgName, slug)
+	} else {
+		idpGroupList, resp, err = client.Teams.ListIDPGroupsForTeamBySlug(ctx, orgName, slug)
+	}
 	if err != nil {
 		if ghErr, ok := err.(*github.ErrorResponse); ok {
 			if ghErr.Response.StatusCode == http.StatusNotModified {
@@ -118,9 +129,12 @@ func resourceGithubTeamSyncGroupMappingRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	groups, err := flattenGithubIDPGroupList(idpGroupList)
-	if err != nil {
-		return err
+	groups := flattenGithubExternalGroupList(externalGroupList)
+	if !usesExternalGroups {
+		groups, err = flattenGithubIDPGroupList(idpGroupList)
+		if err != nil {
+			return err
+		}
 	}
 
 	if err = d.Set("group", groups); err != nil {
@@ -139,13 +153,9 @@ func resourceGithubTeamSyncGroupMappingUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	client := meta.(*Owner).v3client
-	orgName := meta.(*Owner).name
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	slug := d.Get("team_slug").(string)
 
-	idpGroupList := expandTeamSyncGroups(d)
-	_, _, err = client.Teams.CreateOrUpdateIDPGroupConnectionsBySlug(ctx, orgName, slug, *idpGroupList)
+	err = updateTeamSyncGroupMapping(ctx, d, meta)
 	if err != nil {
 		return err
 	}
@@ -164,6 +174,14 @@ func resourceGithubTeamSyncGroupMappingDelete(d *schema.ResourceData, meta inter
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 	slug := d.Get("team_slug").(string)
 
+	usesExternalGroups, err := organizationUsesExternalGroups(ctx, meta)
+	if err != nil {
+		return err
+	}
+	if usesExternalGroups {
+		return removeConnectedExternalGroup(ctx, client, orgName, slug)
+	}
+
 	groups := make([]*github.IDPGroup, 0)
 	emptyGroupList := github.IDPGroupList{Groups: groups}
 
@@ -171,6 +189,110 @@ func resourceGithubTeamSyncGroupMappingDelete(d *schema.ResourceData, meta inter
 	return err
 }
 
+// updateTeamSyncGroupMapping connects the team to the configured groups,
+// through the external groups API if the organization uses Enterprise
+// Managed Users and through the team synchronization API otherwise.
+func updateTeamSyncGroupMapping(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	slug := d.Get("team_slug").(string)
+
+	usesExternalGroups, err := organizationUsesExternalGroups(ctx, meta)
+	if err != nil {
+		return err
+	}
+	if !usesExternalGroups {
+		idpGroupList := expandTeamSyncGroups(d)
+		_, _, err = client.Teams.CreateOrUpdateIDPGroupConnectionsBySlug(ctx, orgName, slug, *idpGroupList)
+		return err
+	}
+
+	groups := d.Get("group").(*schema.Set).List()
+	switch len(groups) {
+	case 0:
+		return removeConnectedExternalGroup(ctx, client, orgName, slug)
+	case 1:
+		groupID := groups[0].(map[string]interface{})["group_id"].(string)
+		id, err := strconv.ParseInt(groupID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("group_id %q is not the ID of an external group: %s", groupID, err)
+		}
+		_, _, err = client.Teams.UpdateConnectedExternalGroup(ctx, orgName, slug, &github.ExternalGroup{GroupID: github.Int64(id)})
+		return err
+	default:
+		return fmt.Errorf("organization %s uses external groups, so team %s can only be connected to a single group", orgName, slug)
+	}
+}
+
+func removeConnectedExternalGroup(ctx context.Context, client *github.Client, orgName, slug string) error {
+	_, err := client.Teams.RemoveConnectedExternalGroup(ctx, orgName, slug)
+	if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+// externalGroupsOrganizations remembers which organizations use external
+// groups, i.e. belong to an enterprise with Enterprise Managed Users, so
+// that it is only looked up once per organization.
+var externalGroupsOrganizations = &externalGroupsOrganizationCache{orgs: make(map[string]bool)}
+
+type externalGroupsOrganizationCache struct {
+	sync.Mutex
+	orgs map[string]bool
+}
+
+// organizationUsesExternalGroups reports whether the teams of the
+// organization are connected to IdP groups through the external groups API
+// rather than the team synchronization API. Organizations without Enterprise
+// Managed Users can't list their external groups.
+func organizationUsesExternalGroups(ctx context.Context, meta interface{}) (bool, error) {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	c := externalGroupsOrganizations
+	c.Lock()
+	defer c.Unlock()
+
+	if usesExternalGroups, ok := c.orgs[orgName]; ok {
+		return usesExternalGroups, nil
+	}
+
+	_, _, err := client.Teams.ListExternalGroups(ctx, orgName, &github.ListExternalGroupsOptions{
+		ListOptions: github.ListOptions{PerPage: 1},
+	})
+	if err != nil {
+		ghErr, ok := err.(*github.ErrorResponse)
+		if !ok {
+			return false, err
+		}
+		switch ghErr.Response.StatusCode {
+		case http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound:
+		default:
+			return false, err
+		}
+	}
+
+	c.orgs[orgName] = err == nil
+	return err == nil, nil
+}
+
+func flattenGithubExternalGroupList(externalGroupList *github.ExternalGroupList) []interface{} {
+	results := make([]interface{}, 0)
+	if externalGroupList == nil {
+		return results
+	}
+	for _, group := range externalGroupList.Groups {
+		result := make(map[string]interface{})
+		result["group_id"] = strconv.FormatInt(group.GetGroupID(), 10)
+		result["group_name"] = group.GetGroupName()
+		result["group_description"] = ""
+		results = append(results, result)
+	}
+
+	return results
+}
+
 func flattenGithubIDPGroupList(idpGroupList *github.IDPGroupList) ([]interface{}, error) {
 	if idpGroupList == nil {
 		return make([]interface{}, 0), nil
@@ -3,15 +3,153 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/google/go-github/v65/github"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestTeamSyncGroupMappingExternalGroups(t *testing.T) {
+	t.Run("connects the team to an external group in organizations using them", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/emu-org/external-groups?per_page=1",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"groups": [{"group_id": 123, "group_name": "Engineering"}]}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/emu-org/teams/example/external-groups",
+				ExpectedMethod: "PATCH",
+				ExpectedBody:   []byte(`{"group_id":123}` + "\n"),
+				ResponseBody:   `{"group_id": 123, "group_name": "Engineering"}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/emu-org/teams/example/external-groups",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"groups": [{"group_id": 123, "group_name": "Engineering"}]}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "emu-org", v3client: client, IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubTeamSyncGroupMapping().Schema, map[string]interface{}{
+			"team_slug": "example",
+			"group": []interface{}{
+				map[string]interface{}{
+					"group_id":   "123",
+					"group_name": "Engineering",
+				},
+			},
+		})
+
+		err := resourceGithubTeamSyncGroupMappingCreate(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		groups := d.Get("group").(*schema.Set).List()
+		if len(groups) != 1 || groups[0].(map[string]interface{})["group_id"] != "123" {
+			t.Errorf("Unexpected groups %v", groups)
+		}
+	})
+
+	t.Run("rejects more than one external group", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/emu-org-2/external-groups?per_page=1",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"groups": []}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "emu-org-2", v3client: client, IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubTeamSyncGroupMapping().Schema, map[string]interface{}{
+			"team_slug": "example",
+			"group": []interface{}{
+				map[string]interface{}{"group_id": "1", "group_name": "one"},
+				map[string]interface{}{"group_id": "2", "group_name": "two"},
+			},
+		})
+
+		err := resourceGithubTeamSyncGroupMappingCreate(d, meta)
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+	})
+
+	t.Run("uses team synchronization in other organizations", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/sync-org/external-groups?per_page=1",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"message": "This organization is not part of externally managed enterprise."}`,
+				StatusCode:     http.StatusBadRequest,
+			},
+			{
+				ExpectedUri:    "/orgs/sync-org/teams/example/team-sync/group-mappings",
+				ExpectedMethod: "PATCH",
+				ExpectedBody:   []byte(`{"groups":[{"group_id":"abc","group_name":"Engineering","group_description":"All engineers"}]}` + "\n"),
+				ResponseBody:   `{"groups": [{"group_id": "abc", "group_name": "Engineering", "group_description": "All engineers"}]}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/orgs/sync-org/teams/example/team-sync/group-mappings",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"groups": [{"group_id": "abc", "group_name": "Engineering", "group_description": "All engineers"}]}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{name: "sync-org", v3client: client, IsOrganization: true}
+
+		d := schema.TestResourceDataRaw(t, resourceGithubTeamSyncGroupMapping().Schema, map[string]interface{}{
+			"team_slug": "example",
+			"group": []interface{}{
+				map[string]interface{}{
+					"group_id":          "abc",
+					"group_name":        "Engineering",
+					"group_description": "All engineers",
+				},
+			},
+		})
+
+		err := resourceGithubTeamSyncGroupMappingCreate(d, meta)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+
+		if n := d.Get("group").(*schema.Set).Len(); n != 1 {
+			t.Errorf("Expected 1 group, got %d", n)
+		}
+	})
+}
+
 func TestAccGithubTeamSyncGroupMapping_basic(t *testing.T) {
 	if isEnterprise != "true" {
 		t.Skip("Skipping because `ENTERPRISE_ACCOUNT` is not set or set to false")
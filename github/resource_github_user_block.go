@@ -0,0 +1,95 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubUserBlock() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubUserBlockCreate,
+		Read:   resourceGithubUserBlockRead,
+		Delete: resourceGithubUserBlockDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: caseInsensitive(),
+				Description:      "The name of the user to block.",
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubUserBlockCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+	username := d.Get("username").(string)
+
+	_, err := client.Users.BlockUser(ctx, username)
+	if err != nil {
+		return err
+	}
+	d.SetId(username)
+
+	return resourceGithubUserBlockRead(d, meta)
+}
+
+func resourceGithubUserBlockRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	username := d.Id()
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
+	}
+
+	blocked, resp, err := client.Users.IsBlocked(ctx, username)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+		}
+		return err
+	}
+
+	if !blocked {
+		log.Printf("[INFO] Removing user block %s from state because the user is no longer blocked", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("username", username); err != nil {
+		return err
+	}
+	if err = d.Set("etag", resp.Header.Get("ETag")); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubUserBlockDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	_, err := client.Users.UnblockUser(ctx, d.Id())
+	if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,72 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubUserBlock(t *testing.T) {
+	newMeta := func(ts string) *Owner {
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts + "/")
+		client.BaseURL = u
+		return &Owner{name: "example", v3client: client}
+	}
+
+	t.Run("blocks a user", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/user/blocks/spammer",
+				ExpectedMethod: "PUT",
+				StatusCode:     http.StatusNoContent,
+			},
+			{
+				ExpectedUri:    "/user/blocks/spammer",
+				ExpectedMethod: "GET",
+				StatusCode:     http.StatusNoContent,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubUserBlock().Schema, map[string]interface{}{
+			"username": "spammer",
+		})
+
+		err := resourceGithubUserBlockCreate(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if d.Id() != "spammer" {
+			t.Errorf("Unexpected ID %q", d.Id())
+		}
+	})
+
+	t.Run("removes a user that is no longer blocked from state", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/user/blocks/spammer",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"message": "Not Found"}`,
+				StatusCode:     http.StatusNotFound,
+			},
+		})
+		defer ts.Close()
+
+		d := schema.TestResourceDataRaw(t, resourceGithubUserBlock().Schema, map[string]interface{}{
+			"username": "spammer",
+		})
+		d.SetId("spammer")
+
+		err := resourceGithubUserBlockRead(d, newMeta(ts.URL))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if d.Id() != "" {
+			t.Errorf("Expected the block to be removed from state, got ID %q", d.Id())
+		}
+	})
+}
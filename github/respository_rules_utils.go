@@ -1,15 +1,67 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"log"
+	"net/http"
 	"reflect"
 	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ruleCodeScanningTool represents a single tool entry of the code_scanning
+// ruleset rule, which is not yet modelled by go-github.
+type ruleCodeScanningTool struct {
+	AlertsThreshold         string `json:"alerts_threshold"`
+	SecurityAlertsThreshold string `json:"security_alerts_threshold"`
+	Tool                    string `json:"tool"`
+}
+
+// codeScanningRuleParameters represents the parameters of the code_scanning
+// ruleset rule.
+type codeScanningRuleParameters struct {
+	CodeScanningTools []*ruleCodeScanningTool `json:"code_scanning_tools"`
+}
+
+// newCodeScanningRule creates a rule requiring code scanning results from
+// the given tools before a reference is updated.
+func newCodeScanningRule(params *codeScanningRuleParameters) *github.RepositoryRule {
+	bytes, _ := json.Marshal(params)
+	rawParams := json.RawMessage(bytes)
+
+	return &github.RepositoryRule{
+		Type:       "code_scanning",
+		Parameters: &rawParams,
+	}
+}
+
+// pullRequestRuleParameters extends the parameters of the pull_request
+// ruleset rule with the allowed merge methods, which go-github doesn't model
+// yet.
+type pullRequestRuleParameters struct {
+	github.PullRequestRuleParameters
+	AllowedMergeMethods []string `json:"allowed_merge_methods,omitempty"`
+}
+
+// newPullRequestRule creates a rule requiring pull requests before merging.
+func newPullRequestRule(params *pullRequestRuleParameters) *github.RepositoryRule {
+	bytes, _ := json.Marshal(params)
+	rawParams := json.RawMessage(bytes)
+
+	return &github.RepositoryRule{
+		Type:       "pull_request",
+		Parameters: &rawParams,
+	}
+}
+
 func resourceGithubRulesetObject(d *schema.ResourceData, org string) *github.Ruleset {
 	isOrgLevel := len(org) > 0
 
@@ -78,6 +130,248 @@ func flattenBypassActors(bypassActors []*github.BypassActor) []interface{} {
 	return actorsSlice
 }
 
+// findRulesetByName returns the ruleset named name, or nil if there is none.
+func findRulesetByName(rulesets []*github.Ruleset, name string) *github.Ruleset {
+	for _, ruleset := range rulesets {
+		if ruleset.Name == name {
+			return ruleset
+		}
+	}
+	return nil
+}
+
+// rulesetExistsError explains that a ruleset can't be created because one
+// with the same name already exists, and how to import it instead.
+func rulesetExistsError(ruleset *github.Ruleset, target, resourceType, importID string) error {
+	return fmt.Errorf("a ruleset named %q already exists on %s with ID %d; choose a different name, "+
+		"or import it with: terraform import %s.<name> %s", ruleset.Name, target, ruleset.GetID(), resourceType, importID)
+}
+
+// bypassActorIDCache caches actor IDs resolved from slugs so that rulesets
+// sharing the same bypass actors don't repeat the lookup on every apply.
+var bypassActorIDCache = struct {
+	sync.Mutex
+	ids map[string]int64
+}{ids: make(map[string]int64)}
+
+func lookupBypassActorID(ctx context.Context, meta interface{}, actorType, slug string) (int64, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	key := fmt.Sprintf("%s:%s/%s", actorType, owner, slug)
+
+	// The lock is not held during the request, so concurrent lookups of the
+	// same actor may both hit the API, which is harmless.
+	bypassActorIDCache.Lock()
+	id, ok := bypassActorIDCache.ids[key]
+	bypassActorIDCache.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	switch actorType {
+	case "Team":
+		team, _, err := client.Teams.GetTeamBySlug(ctx, owner, slug)
+		if err != nil {
+			return 0, fmt.Errorf("error resolving team %s/%s: %s", owner, slug, err)
+		}
+		id = team.GetID()
+	case "Integration":
+		app, _, err := client.Apps.Get(ctx, slug)
+		if err != nil {
+			return 0, fmt.Errorf("error resolving GitHub App %s: %s", slug, err)
+		}
+		id = app.GetID()
+	default:
+		return 0, fmt.Errorf("actor_slug is only supported for actor_type Team or Integration, got %q", actorType)
+	}
+
+	bypassActorIDCache.Lock()
+	bypassActorIDCache.ids[key] = id
+	bypassActorIDCache.Unlock()
+	return id, nil
+}
+
+// bypassActorsCustomizeDiff fails the plan if a bypass actor sets neither
+// actor_id nor actor_slug, rather than letting it be sent as actor ID 0.
+func bypassActorsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() {
+		return nil
+	}
+	return validateBypassActorsConfig(config.GetAttr("bypass_actors"))
+}
+
+func validateBypassActorsConfig(actors cty.Value) error {
+	if !actors.IsKnown() || actors.IsNull() {
+		return nil
+	}
+
+	for i, it := 0, actors.ElementIterator(); it.Next(); i++ {
+		_, actor := it.Element()
+		if !actor.IsKnown() || actor.IsNull() {
+			continue
+		}
+		if actor.GetAttr("actor_id").IsNull() && actor.GetAttr("actor_slug").IsNull() {
+			return fmt.Errorf("bypass_actors.%d: one of actor_id or actor_slug must be set", i)
+		}
+	}
+	return nil
+}
+
+// resolveBypassActorSlugs sets the actor_id of every bypass actor configured
+// with an actor_slug to the ID of the team or app it refers to.
+func resolveBypassActorSlugs(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	bypassActors := d.Get("bypass_actors").([]interface{})
+
+	for _, v := range bypassActors {
+		actor := v.(map[string]interface{})
+		slug, ok := actor["actor_slug"].(string)
+		if !ok || slug == "" {
+			continue
+		}
+
+		id, err := lookupBypassActorID(ctx, meta, actor["actor_type"].(string), slug)
+		if err != nil {
+			return err
+		}
+		actor["actor_id"] = int(id)
+	}
+
+	return d.Set("bypass_actors", bypassActors)
+}
+
+// githubActionsAppID is the ID of the built-in GitHub Actions app. It can act
+// on every repository with Actions enabled without being installed.
+const githubActionsAppID = 15368
+
+// checkBypassActorInstallations returns an error if a GitHub App configured as
+// a bypass actor is not installed on the organization owning the repository.
+// Installations limited to selected repositories can't be listed with a user
+// token, so for those the repository itself is not verified.
+func checkBypassActorInstallations(ctx context.Context, meta interface{}, repoName string, bypassActors []interface{}) error {
+	if !meta.(*Owner).IsOrganization {
+		return nil
+	}
+
+	var appIDs []int64
+	for _, v := range bypassActors {
+		actor := v.(map[string]interface{})
+		if actor["actor_type"] != "Integration" {
+			continue
+		}
+		if id := int64(actor["actor_id"].(int)); id != githubActionsAppID {
+			appIDs = append(appIDs, id)
+		}
+	}
+	if len(appIDs) == 0 {
+		return nil
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	installations := make(map[int64]*github.Installation)
+	options := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+	for {
+		result, resp, err := client.Organizations.ListInstallations(ctx, owner, options)
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok {
+				if ghErr.Response.StatusCode == http.StatusForbidden || ghErr.Response.StatusCode == http.StatusNotFound {
+					log.Printf("[WARN] Unable to list GitHub App installations of %s to check bypass actors: %s", owner, err)
+					return nil
+				}
+			}
+			return err
+		}
+
+		for _, installation := range result.Installations {
+			installations[installation.GetAppID()] = installation
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	for _, id := range appIDs {
+		installation, ok := installations[id]
+		if !ok {
+			return fmt.Errorf("GitHub App %d is not installed on %s and can't bypass rulesets of repository %s", id, owner, repoName)
+		}
+		if installation.GetRepositorySelection() == "selected" {
+			log.Printf("[DEBUG] GitHub App %d is installed on selected repositories of %s, not checking %s", id, owner, repoName)
+		}
+	}
+
+	return nil
+}
+
+// preserveBypassActorSlugs copies the actor_slug of previously known bypass
+// actors onto the flattened API response, which only carries actor IDs.
+func preserveBypassActorSlugs(flattened []interface{}, previous []interface{}) []interface{} {
+	for _, f := range flattened {
+		actor := f.(map[string]interface{})
+		for _, p := range previous {
+			prev := p.(map[string]interface{})
+			slug, ok := prev["actor_slug"].(string)
+			if !ok || slug == "" {
+				continue
+			}
+			if prev["actor_type"] == actor["actor_type"] && int64(prev["actor_id"].(int)) == actor["actor_id"] {
+				actor["actor_slug"] = slug
+				break
+			}
+		}
+	}
+
+	return flattened
+}
+
+// The symbolic ref name patterns, which follow the repository's default
+// branch instead of hard-coding it.
+const (
+	rulesetRefNameDefaultBranch = "~DEFAULT_BRANCH"
+	rulesetRefNameAll           = "~ALL"
+)
+
+func rulesetRefNamePatternSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		ValidateDiagFunc: toDiagFunc(validateRulesetRefNamePattern, "ref_name"),
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return normalizeRulesetRefNamePattern(old) == normalizeRulesetRefNamePattern(new)
+		},
+	}
+}
+
+// normalizeRulesetRefNamePattern returns the canonical spelling of the
+// symbolic patterns, leaving other patterns untouched.
+func normalizeRulesetRefNamePattern(pattern string) string {
+	for _, symbol := range []string{rulesetRefNameDefaultBranch, rulesetRefNameAll} {
+		if strings.EqualFold(pattern, symbol) {
+			return symbol
+		}
+	}
+	return pattern
+}
+
+func validateRulesetRefNamePattern(v interface{}, k string) ([]string, []error) {
+	pattern, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	normalized := normalizeRulesetRefNamePattern(pattern)
+	if strings.HasPrefix(normalized, "~") && normalized != rulesetRefNameDefaultBranch && normalized != rulesetRefNameAll {
+		return nil, []error{fmt.Errorf("%s contains unknown pattern %q, expected %q, %q or a ref name pattern such as %q",
+			k, pattern, rulesetRefNameDefaultBranch, rulesetRefNameAll, "refs/heads/main")}
+	}
+	return nil, nil
+}
+
 func expandConditions(input []interface{}, org bool) *github.RulesetConditions {
 	if len(input) == 0 || input[0] == nil {
 		return nil
@@ -93,13 +387,13 @@ func expandConditions(input []interface{}, org bool) *github.RulesetConditions {
 
 		for _, v := range inputRefName["include"].([]interface{}) {
 			if v != nil {
-				include = append(include, v.(string))
+				include = append(include, normalizeRulesetRefNamePattern(v.(string)))
 			}
 		}
 
 		for _, v := range inputRefName["exclude"].([]interface{}) {
 			if v != nil {
-				exclude = append(exclude, v.(string))
+				exclude = append(exclude, normalizeRulesetRefNamePattern(v.(string)))
 			}
 		}
 
@@ -146,6 +440,20 @@ func expandConditions(input []interface{}, org bool) *github.RulesetConditions {
 			}
 
 			rulesetConditions.RepositoryID = &github.RulesetRepositoryIDsConditionParameters{RepositoryIDs: repositoryIDs}
+		} else if v, ok := inputConditions["repository_property"].([]interface{}); ok && v != nil && len(v) != 0 {
+			include := make([]github.RulesetRepositoryPropertyTargetParameters, 0)
+			exclude := make([]github.RulesetRepositoryPropertyTargetParameters, 0)
+
+			if v[0] != nil {
+				inputRepositoryProperty := v[0].(map[string]interface{})
+				include = expandRepositoryPropertyTargets(inputRepositoryProperty["include"].([]interface{}))
+				exclude = expandRepositoryPropertyTargets(inputRepositoryProperty["exclude"].([]interface{}))
+			}
+
+			rulesetConditions.RepositoryProperty = &github.RulesetRepositoryPropertyConditionParameters{
+				Include: include,
+				Exclude: exclude,
+			}
 		}
 	}
 
@@ -189,11 +497,63 @@ func flattenConditions(conditions *github.RulesetConditions, org bool) []interfa
 		if conditions.RepositoryID != nil {
 			conditionsMap["repository_id"] = conditions.RepositoryID.RepositoryIDs
 		}
+
+		if conditions.RepositoryProperty != nil {
+			conditionsMap["repository_property"] = []map[string]interface{}{{
+				"include": flattenRepositoryPropertyTargets(conditions.RepositoryProperty.Include),
+				"exclude": flattenRepositoryPropertyTargets(conditions.RepositoryProperty.Exclude),
+			}}
+		}
 	}
 
 	return []interface{}{conditionsMap}
 }
 
+func expandRepositoryPropertyTargets(input []interface{}) []github.RulesetRepositoryPropertyTargetParameters {
+	targets := make([]github.RulesetRepositoryPropertyTargetParameters, 0, len(input))
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+		target := v.(map[string]interface{})
+
+		values := make([]string, 0)
+		for _, value := range target["property_values"].([]interface{}) {
+			if value != nil {
+				values = append(values, value.(string))
+			}
+		}
+
+		targets = append(targets, github.RulesetRepositoryPropertyTargetParameters{
+			Name:   target["name"].(string),
+			Values: values,
+			Source: target["source"].(string),
+		})
+	}
+
+	return targets
+}
+
+func flattenRepositoryPropertyTargets(targets []github.RulesetRepositoryPropertyTargetParameters) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(targets))
+
+	for _, target := range targets {
+		source := target.Source
+		if source == "" {
+			source = "custom"
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":            target.Name,
+			"property_values": target.Values,
+			"source":          source,
+		})
+	}
+
+	return result
+}
+
 func expandRules(input []interface{}, org bool) []*github.RepositoryRule {
 	if len(input) == 0 || input[0] == nil {
 		return nil
@@ -209,10 +569,8 @@ func expandRules(input []interface{}, org bool) []*github.RepositoryRule {
 
 	if v, ok := rulesMap["update"].(bool); ok && v {
 		params := github.UpdateAllowsFetchAndMergeRuleParameters{}
-		if fetchAndMerge, ok := rulesMap["update"].(bool); ok && fetchAndMerge {
+		if fetchAndMerge, ok := rulesMap["update_allows_fetch_and_merge"].(bool); ok && fetchAndMerge {
 			params.UpdateAllowsFetchAndMerge = true
-		} else {
-			params.UpdateAllowsFetchAndMerge = false
 		}
 		rulesSlice = append(rulesSlice, github.NewUpdateRule(&params))
 	}
@@ -289,15 +647,24 @@ func expandRules(input []interface{}, org bool) []*github.RepositoryRule {
 	// Pull request rule
 	if v, ok := rulesMap["pull_request"].([]interface{}); ok && len(v) != 0 {
 		pullRequestMap := v[0].(map[string]interface{})
-		params := &github.PullRequestRuleParameters{
-			DismissStaleReviewsOnPush:      pullRequestMap["dismiss_stale_reviews_on_push"].(bool),
-			RequireCodeOwnerReview:         pullRequestMap["require_code_owner_review"].(bool),
-			RequireLastPushApproval:        pullRequestMap["require_last_push_approval"].(bool),
-			RequiredApprovingReviewCount:   pullRequestMap["required_approving_review_count"].(int),
-			RequiredReviewThreadResolution: pullRequestMap["required_review_thread_resolution"].(bool),
+		params := &pullRequestRuleParameters{
+			PullRequestRuleParameters: github.PullRequestRuleParameters{
+				DismissStaleReviewsOnPush:      pullRequestMap["dismiss_stale_reviews_on_push"].(bool),
+				RequireCodeOwnerReview:         pullRequestMap["require_code_owner_review"].(bool),
+				RequireLastPushApproval:        pullRequestMap["require_last_push_approval"].(bool),
+				RequiredApprovingReviewCount:   pullRequestMap["required_approving_review_count"].(int),
+				RequiredReviewThreadResolution: pullRequestMap["required_review_thread_resolution"].(bool),
+			},
 		}
 
-		rulesSlice = append(rulesSlice, github.NewPullRequestRule(params))
+		if allowedMergeMethods, ok := pullRequestMap["allowed_merge_methods"].(*schema.Set); ok {
+			for _, method := range allowedMergeMethods.List() {
+				params.AllowedMergeMethods = append(params.AllowedMergeMethods, method.(string))
+			}
+			sort.Strings(params.AllowedMergeMethods)
+		}
+
+		rulesSlice = append(rulesSlice, newPullRequestRule(params))
 	}
 
 	// Required status checks rule
@@ -327,6 +694,7 @@ func expandRules(input []interface{}, org bool) []*github.RepositoryRule {
 		params := &github.RequiredStatusChecksRuleParameters{
 			RequiredStatusChecks:             requiredStatusChecks,
 			StrictRequiredStatusChecksPolicy: requiredStatusMap["strict_required_status_checks_policy"].(bool),
+			DoNotEnforceOnCreate:             requiredStatusMap["do_not_enforce_on_create"].(bool),
 		}
 		rulesSlice = append(rulesSlice, github.NewRequiredStatusChecksRule(params))
 	}
@@ -352,6 +720,10 @@ func expandRules(input []interface{}, org bool) []*github.RepositoryRule {
 					Ref:          ref,
 				}
 
+				if sha, ok := workflow["sha"].(string); ok && sha != "" {
+					params.Sha = github.String(sha)
+				}
+
 				requiredWorkflows = append(requiredWorkflows, params)
 			}
 		}
@@ -362,9 +734,155 @@ func expandRules(input []interface{}, org bool) []*github.RepositoryRule {
 		rulesSlice = append(rulesSlice, github.NewRequiredWorkflowsRule(params))
 	}
 
+	// Code scanning tools rule
+	if v, ok := rulesMap["code_scanning"].([]interface{}); ok && len(v) != 0 {
+		codeScanningMap := v[0].(map[string]interface{})
+		codeScanningTools := make([]*ruleCodeScanningTool, 0)
+
+		if codeScanningToolsInput, ok := codeScanningMap["code_scanning_tool"]; ok {
+
+			codeScanningToolsSet := codeScanningToolsInput.(*schema.Set)
+			for _, toolMap := range codeScanningToolsSet.List() {
+				tool := toolMap.(map[string]interface{})
+
+				codeScanningTools = append(codeScanningTools, &ruleCodeScanningTool{
+					AlertsThreshold:         tool["alerts_threshold"].(string),
+					SecurityAlertsThreshold: tool["security_alerts_threshold"].(string),
+					Tool:                    tool["tool"].(string),
+				})
+			}
+		}
+
+		params := &codeScanningRuleParameters{
+			CodeScanningTools: codeScanningTools,
+		}
+		rulesSlice = append(rulesSlice, newCodeScanningRule(params))
+	}
+
 	return rulesSlice
 }
 
+// rulesetRuleTypes are the types of rules supported by the rules block. Rules
+// of other types can only be managed through their raw JSON.
+var rulesetRuleTypes = map[string]bool{
+	"creation":                    true,
+	"deletion":                    true,
+	"required_linear_history":     true,
+	"required_signatures":         true,
+	"non_fast_forward":            true,
+	"update":                      true,
+	"commit_message_pattern":      true,
+	"commit_author_email_pattern": true,
+	"committer_email_pattern":     true,
+	"branch_name_pattern":         true,
+	"tag_name_pattern":            true,
+	"required_deployments":        true,
+	"pull_request":                true,
+	"required_status_checks":      true,
+	"workflows":                   true,
+	"code_scanning":               true,
+}
+
+// rawRulesetRule represents a ruleset rule of any type. go-github refuses to
+// decode the rulesets containing rules of types it doesn't know about yet.
+type rawRulesetRule struct {
+	Type       string           `json:"type"`
+	Parameters *json.RawMessage `json:"parameters,omitempty"`
+}
+
+// getRulesetJSON gets the ruleset at the given URL along with its JSON. Only
+// the rules supported by the rules block are set on the returned ruleset, the
+// other ones are returned separately.
+func getRulesetJSON(ctx context.Context, client *github.Client, u string) (*github.Ruleset, []rawRulesetRule, []byte, *github.Response, error) {
+	return doRulesetRequest(ctx, client, "GET", u, nil)
+}
+
+// doRulesetRequest sends a request whose response is a ruleset and decodes it
+// like getRulesetJSON. go-github fails to decode rulesets containing rules it
+// doesn't know about (e.g. code_scanning), so rulesets are never created,
+// updated or read through its rulesets service.
+func doRulesetRequest(ctx context.Context, client *github.Client, method, u string, ruleset interface{}) (*github.Ruleset, []rawRulesetRule, []byte, *github.Response, error) {
+	req, err := client.NewRequest(method, u, ruleset)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	var body json.RawMessage
+	resp, err := client.Do(ctx, req, &body)
+	if err != nil {
+		return nil, nil, nil, resp, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(body, &fields); err != nil {
+		return nil, nil, nil, resp, err
+	}
+	var rules []rawRulesetRule
+	if v, ok := fields["rules"]; ok {
+		if err = json.Unmarshal(v, &rules); err != nil {
+			return nil, nil, nil, resp, err
+		}
+		delete(fields, "rules")
+	}
+
+	withoutRules, err := json.Marshal(fields)
+	if err != nil {
+		return nil, nil, nil, resp, err
+	}
+	result := new(github.Ruleset)
+	if err = json.Unmarshal(withoutRules, result); err != nil {
+		return nil, nil, nil, resp, err
+	}
+
+	otherRules := make([]rawRulesetRule, 0)
+	for _, rule := range rules {
+		if rulesetRuleTypes[rule.Type] {
+			result.Rules = append(result.Rules, &github.RepositoryRule{Type: rule.Type, Parameters: rule.Parameters})
+		} else {
+			otherRules = append(otherRules, rule)
+		}
+	}
+
+	return result, otherRules, body, resp, nil
+}
+
+// expandRawRules parses a JSON array of rules of types not supported by the
+// rules block.
+func expandRawRules(input string) ([]*github.RepositoryRule, error) {
+	if input == "" {
+		return nil, nil
+	}
+
+	var rules []rawRulesetRule
+	if err := json.Unmarshal([]byte(input), &rules); err != nil {
+		return nil, fmt.Errorf("raw_rules_json must be a JSON array of rules: %s", err)
+	}
+
+	rulesSlice := make([]*github.RepositoryRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Type == "" {
+			return nil, fmt.Errorf("every rule of raw_rules_json must have a type")
+		}
+		if rulesetRuleTypes[rule.Type] {
+			return nil, fmt.Errorf("%s rules must be configured in the rules block instead of raw_rules_json", rule.Type)
+		}
+		rulesSlice = append(rulesSlice, &github.RepositoryRule{Type: rule.Type, Parameters: rule.Parameters})
+	}
+
+	return rulesSlice, nil
+}
+
+func flattenRawRules(rules []rawRulesetRule) (string, error) {
+	if rules == nil {
+		rules = make([]rawRulesetRule, 0)
+	}
+	bytes, err := json.Marshal(rules)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
 func flattenRules(rules []*github.RepositoryRule, org bool) []interface{} {
 	if len(rules) == 0 || rules == nil {
 		return []interface{}{}
@@ -431,7 +949,7 @@ func flattenRules(rules []*github.RepositoryRule, org bool) []interface{} {
 			}
 
 		case "pull_request":
-			var params github.PullRequestRuleParameters
+			var params pullRequestRuleParameters
 
 			err := json.Unmarshal(*v.Parameters, &params)
 			if err != nil {
@@ -445,6 +963,7 @@ func flattenRules(rules []*github.RepositoryRule, org bool) []interface{} {
 			rule["require_last_push_approval"] = params.RequireLastPushApproval
 			rule["required_approving_review_count"] = params.RequiredApprovingReviewCount
 			rule["required_review_thread_resolution"] = params.RequiredReviewThreadResolution
+			rule["allowed_merge_methods"] = params.AllowedMergeMethods
 			rulesMap[v.Type] = []map[string]interface{}{rule}
 
 		case "required_status_checks":
@@ -471,6 +990,52 @@ func flattenRules(rules []*github.RepositoryRule, org bool) []interface{} {
 			rule := make(map[string]interface{})
 			rule["required_check"] = requiredStatusChecksSlice
 			rule["strict_required_status_checks_policy"] = params.StrictRequiredStatusChecksPolicy
+			rule["do_not_enforce_on_create"] = params.DoNotEnforceOnCreate
+			rulesMap[v.Type] = []map[string]interface{}{rule}
+
+		case "workflows":
+			var params github.RequiredWorkflowsRuleParameters
+
+			err := json.Unmarshal(*v.Parameters, &params)
+			if err != nil {
+				log.Printf("[INFO] Unexpected error unmarshalling rule %s with parameters: %v",
+					v.Type, v.Parameters)
+			}
+
+			requiredWorkflowsSlice := make([]map[string]interface{}, 0)
+			for _, workflow := range params.RequiredWorkflows {
+				requiredWorkflowsSlice = append(requiredWorkflowsSlice, map[string]interface{}{
+					"repository_id": workflow.GetRepositoryID(),
+					"path":          workflow.Path,
+					"ref":           workflow.GetRef(),
+					"sha":           workflow.GetSha(),
+				})
+			}
+
+			rule := make(map[string]interface{})
+			rule["required_workflow"] = requiredWorkflowsSlice
+			rulesMap["required_workflows"] = []map[string]interface{}{rule}
+
+		case "code_scanning":
+			var params codeScanningRuleParameters
+
+			err := json.Unmarshal(*v.Parameters, &params)
+			if err != nil {
+				log.Printf("[INFO] Unexpected error unmarshalling rule %s with parameters: %v",
+					v.Type, v.Parameters)
+			}
+
+			codeScanningToolsSlice := make([]map[string]interface{}, 0)
+			for _, tool := range params.CodeScanningTools {
+				codeScanningToolsSlice = append(codeScanningToolsSlice, map[string]interface{}{
+					"alerts_threshold":          tool.AlertsThreshold,
+					"security_alerts_threshold": tool.SecurityAlertsThreshold,
+					"tool":                      tool.Tool,
+				})
+			}
+
+			rule := make(map[string]interface{})
+			rule["code_scanning_tool"] = codeScanningToolsSlice
 			rulesMap[v.Type] = []map[string]interface{}{rule}
 		}
 	}
@@ -478,6 +1043,61 @@ func flattenRules(rules []*github.RepositoryRule, org bool) []interface{} {
 	return []interface{}{rulesMap}
 }
 
+func rulesetDestroyGracePeriodSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validateRulesetDestroyGracePeriod,
+		Description:  "How long to wait between disabling the ruleset and deleting it when it is destroyed, e.g. `5m`, so that merges already underway can complete. Must be shorter than the delete timeout. If not set, the ruleset is deleted right away.",
+	}
+}
+
+func validateRulesetDestroyGracePeriod(v interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration such as 5m or 1h: %s", k, err)}
+	}
+	return nil, nil
+}
+
+// disableRulesetBeforeDestroy disables the ruleset at the given URL and waits
+// for the destroy_grace_period of the ruleset, if any, before it is deleted.
+// Disabling the ruleset is recorded in the audit log of the owner. The wait is
+// cut short if ctx is done, e.g. when the delete timeout is reached.
+func disableRulesetBeforeDestroy(ctx context.Context, client *github.Client, u string, d *schema.ResourceData) error {
+	gracePeriod := d.Get("destroy_grace_period").(string)
+	if gracePeriod == "" || d.Get("enforcement").(string) == "disabled" {
+		return nil
+	}
+	duration, err := time.ParseDuration(gracePeriod)
+	if err != nil {
+		return err
+	}
+	if timeout := d.Timeout(schema.TimeoutDelete); duration >= timeout {
+		return fmt.Errorf("destroy_grace_period (%s) must be shorter than the delete timeout (%s), "+
+			"which can be raised in the timeouts block of the ruleset", duration, timeout)
+	}
+
+	log.Printf("[INFO] Disabling ruleset %s %s before deleting it", d.Id(), duration)
+	// go-github would send the name of the ruleset even if it is empty.
+	req, err := client.NewRequest("PUT", u, &struct {
+		Enforcement string `json:"enforcement"`
+	}{Enforcement: "disabled"})
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(ctx, req, nil)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("ruleset %s was disabled but not deleted: %w", d.Id(), ctx.Err())
+	case <-time.After(duration):
+		return nil
+	}
+}
+
 func bypassActorsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	// If the length has changed, no need to suppress
 	if k == "bypass_actors.#" {
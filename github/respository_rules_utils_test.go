@@ -0,0 +1,667 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandAndFlattenRequiredWorkflowsRule(t *testing.T) {
+	workflowHash := schema.HashResource(&schema.Resource{Schema: map[string]*schema.Schema{
+		"repository_id": {Type: schema.TypeInt},
+		"path":          {Type: schema.TypeString},
+		"ref":           {Type: schema.TypeString},
+		"sha":           {Type: schema.TypeString},
+	}})
+
+	rulesMap := map[string]interface{}{
+		"required_workflows": []interface{}{
+			map[string]interface{}{
+				"required_workflow": schema.NewSet(workflowHash, []interface{}{
+					map[string]interface{}{
+						"repository_id": 1234,
+						"path":          ".github/workflows/ci.yml",
+						"ref":           "main",
+						"sha":           "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+					},
+				}),
+			},
+		},
+	}
+
+	rules := expandRules([]interface{}{rulesMap}, false)
+	if len(rules) != 1 {
+		t.Fatalf("Expected 1 rule, got %d", len(rules))
+	}
+	if rules[0].Type != "workflows" {
+		t.Fatalf("Expected rule of type workflows, got %s", rules[0].Type)
+	}
+
+	flattened := flattenRules([]*github.RepositoryRule{rules[0]}, false)
+	flattenedRules := flattened[0].(map[string]interface{})
+
+	requiredWorkflows, ok := flattenedRules["required_workflows"].([]map[string]interface{})
+	if !ok || len(requiredWorkflows) != 1 {
+		t.Fatalf("Expected required_workflows to be flattened, got %#v", flattenedRules["required_workflows"])
+	}
+
+	workflows := requiredWorkflows[0]["required_workflow"].([]map[string]interface{})
+	if len(workflows) != 1 {
+		t.Fatalf("Expected 1 required workflow, got %d", len(workflows))
+	}
+
+	workflow := workflows[0]
+	if workflow["repository_id"] != int64(1234) {
+		t.Errorf("Expected repository_id 1234, got %v", workflow["repository_id"])
+	}
+	if workflow["path"] != ".github/workflows/ci.yml" {
+		t.Errorf("Expected path .github/workflows/ci.yml, got %v", workflow["path"])
+	}
+	if workflow["ref"] != "main" {
+		t.Errorf("Expected ref main, got %v", workflow["ref"])
+	}
+	if workflow["sha"] != "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" {
+		t.Errorf("Expected sha to be preserved, got %v", workflow["sha"])
+	}
+}
+
+func TestExpandAndFlattenCodeScanningRule(t *testing.T) {
+	toolHash := schema.HashResource(&schema.Resource{Schema: map[string]*schema.Schema{
+		"tool":                      {Type: schema.TypeString},
+		"alerts_threshold":          {Type: schema.TypeString},
+		"security_alerts_threshold": {Type: schema.TypeString},
+	}})
+
+	rulesMap := map[string]interface{}{
+		"code_scanning": []interface{}{
+			map[string]interface{}{
+				"code_scanning_tool": schema.NewSet(toolHash, []interface{}{
+					map[string]interface{}{
+						"tool":                      "CodeQL",
+						"alerts_threshold":          "errors",
+						"security_alerts_threshold": "high_or_higher",
+					},
+				}),
+			},
+		},
+	}
+
+	rules := expandRules([]interface{}{rulesMap}, true)
+	if len(rules) != 1 {
+		t.Fatalf("Expected 1 rule, got %d", len(rules))
+	}
+	if rules[0].Type != "code_scanning" {
+		t.Fatalf("Expected rule of type code_scanning, got %s", rules[0].Type)
+	}
+
+	flattened := flattenRules([]*github.RepositoryRule{rules[0]}, true)
+	flattenedRules := flattened[0].(map[string]interface{})
+
+	codeScanning, ok := flattenedRules["code_scanning"].([]map[string]interface{})
+	if !ok || len(codeScanning) != 1 {
+		t.Fatalf("Expected code_scanning to be flattened, got %#v", flattenedRules["code_scanning"])
+	}
+
+	tools := codeScanning[0]["code_scanning_tool"].([]map[string]interface{})
+	if len(tools) != 1 {
+		t.Fatalf("Expected 1 code scanning tool, got %d", len(tools))
+	}
+
+	tool := tools[0]
+	if tool["tool"] != "CodeQL" {
+		t.Errorf("Expected tool CodeQL, got %v", tool["tool"])
+	}
+	if tool["alerts_threshold"] != "errors" {
+		t.Errorf("Expected alerts_threshold errors, got %v", tool["alerts_threshold"])
+	}
+	if tool["security_alerts_threshold"] != "high_or_higher" {
+		t.Errorf("Expected security_alerts_threshold high_or_higher, got %v", tool["security_alerts_threshold"])
+	}
+}
+
+func TestPreserveBypassActorSlugs(t *testing.T) {
+	flattened := flattenBypassActors([]*github.BypassActor{
+		{ActorID: github.Int64(1234), ActorType: github.String("Team"), BypassMode: github.String("always")},
+		{ActorID: github.Int64(1), ActorType: github.String("OrganizationAdmin"), BypassMode: github.String("always")},
+	})
+
+	previous := []interface{}{
+		map[string]interface{}{
+			"actor_id":    1234,
+			"actor_slug":  "platform",
+			"actor_type":  "Team",
+			"bypass_mode": "always",
+		},
+		map[string]interface{}{
+			"actor_id":    1,
+			"actor_slug":  "",
+			"actor_type":  "OrganizationAdmin",
+			"bypass_mode": "always",
+		},
+	}
+
+	actors := preserveBypassActorSlugs(flattened, previous)
+
+	team := actors[0].(map[string]interface{})
+	if team["actor_slug"] != "platform" {
+		t.Errorf("Expected actor_slug platform to be preserved, got %v", team["actor_slug"])
+	}
+
+	admin := actors[1].(map[string]interface{})
+	if _, ok := admin["actor_slug"]; ok {
+		t.Errorf("Expected no actor_slug for an actor configured by ID, got %v", admin["actor_slug"])
+	}
+}
+
+func TestLookupBypassActorIDUnsupportedType(t *testing.T) {
+	meta := &Owner{name: "example"}
+
+	_, err := lookupBypassActorID(context.Background(), meta, "RepositoryRole", "maintain")
+	if err == nil {
+		t.Fatal("Expected an error for an actor_type without slug support")
+	}
+}
+
+func TestCheckBypassActorInstallations(t *testing.T) {
+	installations := `{"total_count": 1, "installations": [{"id": 1, "app_id": 42, "repository_selection": "all"}]}`
+
+	newMeta := func(responses []*mockResponse) (*Owner, func()) {
+		ts := githubApiMock(responses)
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+		return &Owner{name: "example", v3client: client, IsOrganization: true}, ts.Close
+	}
+
+	integration := func(id int) []interface{} {
+		return []interface{}{
+			map[string]interface{}{"actor_id": id, "actor_type": "Integration", "bypass_mode": "always"},
+		}
+	}
+
+	listInstallations := func(status int, body string) []*mockResponse {
+		return []*mockResponse{
+			{
+				ExpectedUri:  "/orgs/example/installations?per_page=100",
+				ResponseBody: body,
+				StatusCode:   status,
+			},
+		}
+	}
+
+	t.Run("accepts an installed app", func(t *testing.T) {
+		meta, done := newMeta(listInstallations(http.StatusOK, installations))
+		defer done()
+
+		err := checkBypassActorInstallations(context.Background(), meta, "repo", integration(42))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+
+	t.Run("rejects an app that is not installed", func(t *testing.T) {
+		meta, done := newMeta(listInstallations(http.StatusOK, installations))
+		defer done()
+
+		err := checkBypassActorInstallations(context.Background(), meta, "repo", integration(7))
+		if err == nil {
+			t.Fatal("Expected an error for an app that is not installed")
+		}
+	})
+
+	t.Run("skips the GitHub Actions app", func(t *testing.T) {
+		meta, done := newMeta(nil)
+		defer done()
+
+		err := checkBypassActorInstallations(context.Background(), meta, "repo", integration(githubActionsAppID))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+
+	t.Run("ignores missing permission to list installations", func(t *testing.T) {
+		meta, done := newMeta(listInstallations(http.StatusForbidden, `{"message": "Forbidden"}`))
+		defer done()
+
+		err := checkBypassActorInstallations(context.Background(), meta, "repo", integration(7))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+}
+
+func TestExpandAndFlattenUpdateRule(t *testing.T) {
+	for _, fetchAndMerge := range []bool{true, false} {
+		rulesMap := map[string]interface{}{
+			"update":                        true,
+			"update_allows_fetch_and_merge": fetchAndMerge,
+		}
+
+		rules := expandRules([]interface{}{rulesMap}, false)
+		if len(rules) != 1 {
+			t.Fatalf("Expected 1 rule, got %d", len(rules))
+		}
+		if rules[0].Type != "update" {
+			t.Fatalf("Expected rule of type update, got %s", rules[0].Type)
+		}
+
+		flattened := flattenRules([]*github.RepositoryRule{rules[0]}, false)
+		flattenedRules := flattened[0].(map[string]interface{})
+
+		if flattenedRules["update"] != true {
+			t.Errorf("Expected update to be true, got %v", flattenedRules["update"])
+		}
+		if flattenedRules["update_allows_fetch_and_merge"] != fetchAndMerge {
+			t.Errorf("Expected update_allows_fetch_and_merge to be %t, got %v", fetchAndMerge, flattenedRules["update_allows_fetch_and_merge"])
+		}
+	}
+}
+
+func TestExpandAndFlattenRequiredStatusChecksRule(t *testing.T) {
+	checkHash := schema.HashResource(&schema.Resource{Schema: map[string]*schema.Schema{
+		"context":        {Type: schema.TypeString},
+		"integration_id": {Type: schema.TypeInt},
+	}})
+
+	rulesMap := map[string]interface{}{
+		"required_status_checks": []interface{}{
+			map[string]interface{}{
+				"required_check": schema.NewSet(checkHash, []interface{}{
+					map[string]interface{}{
+						"context":        "ci",
+						"integration_id": 0,
+					},
+				}),
+				"strict_required_status_checks_policy": true,
+				"do_not_enforce_on_create":             true,
+			},
+		},
+	}
+
+	rules := expandRules([]interface{}{rulesMap}, false)
+	if len(rules) != 1 {
+		t.Fatalf("Expected 1 rule, got %d", len(rules))
+	}
+	if rules[0].Type != "required_status_checks" {
+		t.Fatalf("Expected rule of type required_status_checks, got %s", rules[0].Type)
+	}
+
+	flattened := flattenRules([]*github.RepositoryRule{rules[0]}, false)
+	flattenedRules := flattened[0].(map[string]interface{})
+
+	requiredStatusChecks, ok := flattenedRules["required_status_checks"].([]map[string]interface{})
+	if !ok || len(requiredStatusChecks) != 1 {
+		t.Fatalf("Expected required_status_checks to be flattened, got %#v", flattenedRules["required_status_checks"])
+	}
+
+	rule := requiredStatusChecks[0]
+	if rule["strict_required_status_checks_policy"] != true {
+		t.Errorf("Expected strict_required_status_checks_policy to be true, got %v", rule["strict_required_status_checks_policy"])
+	}
+	if rule["do_not_enforce_on_create"] != true {
+		t.Errorf("Expected do_not_enforce_on_create to be true, got %v", rule["do_not_enforce_on_create"])
+	}
+}
+
+func TestExpandAndFlattenPullRequestRule(t *testing.T) {
+	rulesMap := map[string]interface{}{
+		"pull_request": []interface{}{
+			map[string]interface{}{
+				"dismiss_stale_reviews_on_push":     true,
+				"require_code_owner_review":         false,
+				"require_last_push_approval":        false,
+				"required_approving_review_count":   2,
+				"required_review_thread_resolution": true,
+				"allowed_merge_methods":             schema.NewSet(schema.HashString, []interface{}{"squash", "rebase"}),
+			},
+		},
+	}
+
+	rules := expandRules([]interface{}{rulesMap}, false)
+	if len(rules) != 1 {
+		t.Fatalf("Expected 1 rule, got %d", len(rules))
+	}
+	if rules[0].Type != "pull_request" {
+		t.Fatalf("Expected rule of type pull_request, got %s", rules[0].Type)
+	}
+
+	flattened := flattenRules([]*github.RepositoryRule{rules[0]}, false)
+	flattenedRules := flattened[0].(map[string]interface{})
+
+	pullRequest, ok := flattenedRules["pull_request"].([]map[string]interface{})
+	if !ok || len(pullRequest) != 1 {
+		t.Fatalf("Expected pull_request to be flattened, got %#v", flattenedRules["pull_request"])
+	}
+
+	rule := pullRequest[0]
+	if rule["required_approving_review_count"] != 2 {
+		t.Errorf("Expected required_approving_review_count 2, got %v", rule["required_approving_review_count"])
+	}
+	if rule["dismiss_stale_reviews_on_push"] != true {
+		t.Errorf("Expected dismiss_stale_reviews_on_push to be true, got %v", rule["dismiss_stale_reviews_on_push"])
+	}
+	if !reflect.DeepEqual(rule["allowed_merge_methods"], []string{"rebase", "squash"}) {
+		t.Errorf("Expected allowed_merge_methods [rebase squash], got %v", rule["allowed_merge_methods"])
+	}
+}
+
+func TestExpandAndFlattenRepositoryPropertyConditions(t *testing.T) {
+	conditionsMap := map[string]interface{}{
+		"ref_name": []interface{}{
+			map[string]interface{}{
+				"include": []interface{}{"~DEFAULT_BRANCH"},
+				"exclude": []interface{}{},
+			},
+		},
+		"repository_name": []interface{}{},
+		"repository_id":   []interface{}{},
+		"repository_property": []interface{}{
+			map[string]interface{}{
+				"include": []interface{}{
+					map[string]interface{}{
+						"name":            "environment",
+						"property_values": []interface{}{"production", "staging"},
+						"source":          "custom",
+					},
+				},
+				"exclude": []interface{}{},
+			},
+		},
+	}
+
+	conditions := expandConditions([]interface{}{conditionsMap}, true)
+	if conditions.RepositoryProperty == nil {
+		t.Fatalf("Expected repository_property condition, got %#v", conditions)
+	}
+	if conditions.RepositoryName != nil || conditions.RepositoryID != nil {
+		t.Errorf("Expected only the repository_property condition to be set, got %#v", conditions)
+	}
+
+	expectedInclude := []github.RulesetRepositoryPropertyTargetParameters{
+		{Name: "environment", Values: []string{"production", "staging"}, Source: "custom"},
+	}
+	if !reflect.DeepEqual(conditions.RepositoryProperty.Include, expectedInclude) {
+		t.Errorf("Unexpected include %#v", conditions.RepositoryProperty.Include)
+	}
+	if len(conditions.RepositoryProperty.Exclude) != 0 {
+		t.Errorf("Expected no excludes, got %#v", conditions.RepositoryProperty.Exclude)
+	}
+
+	flattened := flattenConditions(conditions, true)
+	flattenedConditions := flattened[0].(map[string]interface{})
+
+	repositoryProperty, ok := flattenedConditions["repository_property"].([]map[string]interface{})
+	if !ok || len(repositoryProperty) != 1 {
+		t.Fatalf("Expected repository_property to be flattened, got %#v", flattenedConditions["repository_property"])
+	}
+
+	include := repositoryProperty[0]["include"].([]map[string]interface{})
+	if len(include) != 1 || include[0]["name"] != "environment" || include[0]["source"] != "custom" {
+		t.Errorf("Unexpected flattened include %#v", include)
+	}
+}
+
+func TestRulesetRefNamePatterns(t *testing.T) {
+	for _, pattern := range []string{"~DEFAULT_BRANCH", "~all", "refs/heads/main", "refs/heads/release/*"} {
+		if _, errs := validateRulesetRefNamePattern(pattern, "include"); len(errs) != 0 {
+			t.Errorf("Expected %q to be valid, got %v", pattern, errs)
+		}
+	}
+	if _, errs := validateRulesetRefNamePattern("~DEFAULT", "include"); len(errs) != 1 {
+		t.Errorf("Expected ~DEFAULT to be invalid")
+	}
+
+	conditions := expandConditions([]interface{}{map[string]interface{}{
+		"ref_name": []interface{}{
+			map[string]interface{}{
+				"include": []interface{}{"~default_branch", "refs/heads/Main"},
+				"exclude": []interface{}{"~All"},
+			},
+		},
+	}}, false)
+
+	if !reflect.DeepEqual(conditions.RefName.Include, []string{"~DEFAULT_BRANCH", "refs/heads/Main"}) {
+		t.Errorf("Unexpected include %#v", conditions.RefName.Include)
+	}
+	if !reflect.DeepEqual(conditions.RefName.Exclude, []string{"~ALL"}) {
+		t.Errorf("Unexpected exclude %#v", conditions.RefName.Exclude)
+	}
+}
+
+func TestCheckRepositoryRulesetNameAvailable(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/repos/example/hello-world/rulesets?includes_parents=false",
+			ResponseBody: `[{"id": 42, "name": "main"}]`,
+			StatusCode:   http.StatusOK,
+		},
+		{
+			ExpectedUri:  "/repos/example/hello-world/rulesets?includes_parents=false",
+			ResponseBody: `[{"id": 42, "name": "main"}]`,
+			StatusCode:   http.StatusOK,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	err := checkRepositoryRulesetNameAvailable(context.Background(), client, "example", "hello-world", "release")
+	if err != nil {
+		t.Fatalf("Expected no error for an unused name, got %s", err)
+	}
+
+	err = checkRepositoryRulesetNameAvailable(context.Background(), client, "example", "hello-world", "main")
+	expected := `a ruleset named "main" already exists on repository example/hello-world with ID 42; choose a different name, ` +
+		`or import it with: terraform import github_repository_ruleset.<name> hello-world:42`
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error %q, got %v", expected, err)
+	}
+}
+
+func TestGetRulesetJSON(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/example/repo/rulesets/1?includes_parents=false",
+			ExpectedMethod: "GET",
+			ResponseBody: `{"id": 1, "name": "main", "enforcement": "active", "rules": [
+				{"type": "creation"},
+				{"type": "merge_queue", "parameters": {"merge_method": "SQUASH"}}
+			]}`,
+			StatusCode: http.StatusOK,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	ruleset, otherRules, rulesetJSON, _, err := getRulesetJSON(context.Background(), client, "repos/example/repo/rulesets/1?includes_parents=false")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	if ruleset.Name != "main" || len(ruleset.Rules) != 1 || ruleset.Rules[0].Type != "creation" {
+		t.Errorf("Unexpected ruleset %+v", ruleset)
+	}
+	if len(rulesetJSON) == 0 {
+		t.Error("Expected the JSON of the ruleset")
+	}
+
+	rawRules, err := flattenRawRules(otherRules)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if rawRules != `[{"type":"merge_queue","parameters":{"merge_method":"SQUASH"}}]` {
+		t.Errorf("Unexpected raw rules %s", rawRules)
+	}
+}
+
+func TestExpandRawRules(t *testing.T) {
+	t.Run("expands rules of other types", func(t *testing.T) {
+		rules, err := expandRawRules(`[{"type": "merge_queue", "parameters": {"merge_method": "SQUASH"}}]`)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if len(rules) != 1 || rules[0].Type != "merge_queue" || string(*rules[0].Parameters) != `{"merge_method": "SQUASH"}` {
+			t.Errorf("Unexpected rules %+v", rules)
+		}
+	})
+
+	t.Run("rejects rules supported by the rules block", func(t *testing.T) {
+		_, err := expandRawRules(`[{"type": "creation"}]`)
+		if err == nil {
+			t.Fatal("Expected an error for a rule supported by the rules block")
+		}
+	})
+
+	t.Run("rejects rules without a type", func(t *testing.T) {
+		_, err := expandRawRules(`[{"parameters": {}}]`)
+		if err == nil {
+			t.Fatal("Expected an error for a rule without a type")
+		}
+	})
+}
+
+func TestDisableRulesetBeforeDestroy(t *testing.T) {
+	newData := func(t *testing.T, enforcement string) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryRuleset().Schema, map[string]interface{}{
+			"name":                 "main",
+			"repository":           "repo",
+			"target":               "branch",
+			"enforcement":          enforcement,
+			"destroy_grace_period": "1ms",
+		})
+		d.SetId("1")
+		return d
+	}
+
+	newMeta := func(ts string) *Owner {
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts + "/")
+		client.BaseURL = u
+		return &Owner{name: "example", v3client: client}
+	}
+
+	t.Run("disables the ruleset before deleting it", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo/rulesets/1",
+				ExpectedMethod: "PUT",
+				ExpectedBody:   []byte(`{"enforcement":"disabled"}` + "\n"),
+				ResponseBody:   `{"id": 1, "name": "main", "enforcement": "disabled"}`,
+				StatusCode:     http.StatusOK,
+			},
+			{
+				ExpectedUri:    "/repos/example/repo/rulesets/1",
+				ExpectedMethod: "DELETE",
+				StatusCode:     http.StatusNoContent,
+			},
+		})
+		defer ts.Close()
+
+		diags := resourceGithubRepositoryRulesetDelete(context.Background(), newData(t, "active"), newMeta(ts.URL))
+		if diags.HasError() {
+			t.Fatalf("Expected no error, got %v", diags)
+		}
+	})
+
+	t.Run("deletes a disabled ruleset right away", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo/rulesets/1",
+				ExpectedMethod: "DELETE",
+				StatusCode:     http.StatusNoContent,
+			},
+		})
+		defer ts.Close()
+
+		diags := resourceGithubRepositoryRulesetDelete(context.Background(), newData(t, "disabled"), newMeta(ts.URL))
+		if diags.HasError() {
+			t.Fatalf("Expected no error, got %v", diags)
+		}
+	})
+
+	t.Run("refuses a grace period longer than the delete timeout", func(t *testing.T) {
+		d := newData(t, "active")
+		d.Set("destroy_grace_period", "1h")
+
+		err := disableRulesetBeforeDestroy(context.Background(), nil, "repos/example/repo/rulesets/1", d)
+		if err == nil || !strings.Contains(err.Error(), "delete timeout") {
+			t.Fatalf("Expected a delete timeout error, got %v", err)
+		}
+	})
+
+	t.Run("stops waiting once the context is done", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/repos/example/repo/rulesets/1",
+				ExpectedMethod: "PUT",
+				ResponseBody:   `{"id": 1, "name": "main", "enforcement": "disabled"}`,
+				StatusCode:     http.StatusOK,
+			},
+		})
+		defer ts.Close()
+
+		d := newData(t, "active")
+		d.Set("destroy_grace_period", "10m")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := disableRulesetBeforeDestroy(ctx, newMeta(ts.URL).v3client, "repos/example/repo/rulesets/1", d)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Expected the wait to be cut short, got %v", err)
+		}
+	})
+}
+
+func TestValidateBypassActorsConfig(t *testing.T) {
+	actor := func(actorID, actorSlug cty.Value) cty.Value {
+		return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"actor_id":    actorID,
+			"actor_slug":  actorSlug,
+			"actor_type":  cty.StringVal("Team"),
+			"bypass_mode": cty.StringVal("always"),
+		})})
+	}
+
+	t.Run("accepts an actor with an actor_id", func(t *testing.T) {
+		err := validateBypassActorsConfig(actor(cty.NumberIntVal(1), cty.NullVal(cty.String)))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+
+	t.Run("accepts an actor with an actor_slug", func(t *testing.T) {
+		err := validateBypassActorsConfig(actor(cty.NullVal(cty.Number), cty.StringVal("core")))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+
+	t.Run("accepts an actor_id not known yet", func(t *testing.T) {
+		err := validateBypassActorsConfig(actor(cty.UnknownVal(cty.Number), cty.NullVal(cty.String)))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	})
+
+	t.Run("refuses an actor with neither", func(t *testing.T) {
+		err := validateBypassActorsConfig(actor(cty.NullVal(cty.Number), cty.NullVal(cty.String)))
+		if err == nil || !strings.Contains(err.Error(), "one of actor_id or actor_slug must be set") {
+			t.Fatalf("Expected an error, got %v", err)
+		}
+	})
+}
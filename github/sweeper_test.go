@@ -9,6 +9,10 @@ import (
 )
 
 func TestMain(m *testing.M) {
+	if err := setupTestFixtures(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 	resource.TestMain(m)
 }
 
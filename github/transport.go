@@ -2,9 +2,13 @@ package github
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"io"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -52,6 +56,7 @@ type RateLimitTransport struct {
 	writeDelay       time.Duration
 	readDelay        time.Duration
 	parallelRequests bool
+	budget           *rateLimitBudget
 
 	m sync.Mutex
 }
@@ -61,6 +66,15 @@ func (rlt *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, err
 	// If parallel_requests is true skips the lock and allow the parallelism defined by terraform itself.
 	rlt.smartLock(true)
 
+	// Wait for the rate limit to reset rather than dipping into the reserved
+	// buffer of requests.
+	if rlt.budget != nil {
+		if err := rlt.budget.wait(req); err != nil {
+			rlt.smartLock(false)
+			return nil, err
+		}
+	}
+
 	// Sleep for the delay that the last request defined. This delay might be different
 	// for read and write requests. See isWriteMethod for the distinction between them.
 	if rlt.nextRequestDelay > 0 {
@@ -76,6 +90,10 @@ func (rlt *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, err
 		return resp, err
 	}
 
+	if rlt.budget != nil {
+		rlt.budget.update(req, resp)
+	}
+
 	// Make response body accessible for retries & debugging
 	// (work around bug in GitHub SDK)
 	// See https://github.com/google/go-github/pull/986
@@ -93,9 +111,19 @@ func (rlt *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, err
 		retryAfter := arlErr.GetRetryAfter()
 		log.Printf("[DEBUG] Abuse detection mechanism triggered, sleeping for %s before retrying",
 			retryAfter)
-		time.Sleep(retryAfter)
 		rlt.smartLock(false)
-		return rlt.RoundTrip(req)
+		return rlt.retryAfter(req, resp, retryAfter)
+	}
+
+	// go-github only recognizes secondary rate limits sent as 403, GitHub
+	// sends them as 429 as well.
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			rlt.nextRequestDelay = 0
+			log.Printf("[DEBUG] Secondary rate limit reached, sleeping for %s before retrying", retryAfter)
+			rlt.smartLock(false)
+			return rlt.retryAfter(req, resp, retryAfter)
+		}
 	}
 
 	if rlErr, ok := ghErr.(*github.RateLimitError); ok {
@@ -103,9 +131,8 @@ func (rlt *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, err
 		retryAfter := time.Until(rlErr.Rate.Reset.Time)
 		log.Printf("[DEBUG] Rate limit %d reached, sleeping for %s (until %s) before retrying",
 			rlErr.Rate.Limit, retryAfter, time.Now().Add(retryAfter))
-		time.Sleep(retryAfter)
 		rlt.smartLock(false)
-		return rlt.RoundTrip(req)
+		return rlt.retryAfter(req, resp, retryAfter)
 	}
 
 	rlt.smartLock(false)
@@ -113,6 +140,16 @@ func (rlt *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, err
 	return resp, nil
 }
 
+// retryAfter sends the request again once the delay has passed. If the
+// request context is done first, the rate limited response is returned along
+// with the error of the context.
+func (rlt *RateLimitTransport) retryAfter(req *http.Request, resp *http.Response, delay time.Duration) (*http.Response, error) {
+	if err := sleepContext(req.Context(), delay); err != nil {
+		return resp, err
+	}
+	return rlt.RoundTrip(req)
+}
+
 // smartLock wraps the mutex locking system and performs its operation via a boolean input for locking and unlocking.
 // It also skips the locking when parallelRequests is set to true since, in this case, the lock is not needed.
 func (rlt *RateLimitTransport) smartLock(lock bool) {
@@ -166,6 +203,16 @@ func WithReadDelay(d time.Duration) RateLimitTransportOption {
 	}
 }
 
+// WithRateLimitBuffer is used to keep a number of requests of every rate limit
+// in reserve, waiting for the limit to reset once only the buffer is left
+func WithRateLimitBuffer(buffer int) RateLimitTransportOption {
+	return func(rlt *RateLimitTransport) {
+		if buffer > 0 {
+			rlt.budget = newRateLimitBudget(buffer)
+		}
+	}
+}
+
 // WithParallelRequests is used to enforce serial api requests for rate limits
 func WithParallelRequests(p bool) RateLimitTransportOption {
 	return func(rlt *RateLimitTransport) {
@@ -173,6 +220,121 @@ func WithParallelRequests(p bool) RateLimitTransportOption {
 	}
 }
 
+// rateLimitBudget tracks the remaining requests of each rate limit resource
+// (core, search, graphql, ...) as reported by the X-RateLimit-* response
+// headers. Requests are counted against it as they are sent, so that
+// parallel requests don't all spend the last of the budget before any of
+// their responses arrive.
+type rateLimitBudget struct {
+	buffer  int
+	windows map[string]*rateLimitWindow
+
+	m sync.Mutex
+}
+
+type rateLimitWindow struct {
+	remaining int
+	reset     time.Time
+}
+
+func newRateLimitBudget(buffer int) *rateLimitBudget {
+	return &rateLimitBudget{buffer: buffer, windows: make(map[string]*rateLimitWindow)}
+}
+
+// wait blocks until the rate limit the request counts against has more
+// requests remaining than the buffer, and then reserves one of them. It
+// returns the error of the request context if it is done before then.
+func (b *rateLimitBudget) wait(req *http.Request) error {
+	resource := rateLimitResource(req)
+	for {
+		delay := b.reserve(resource)
+		if delay <= 0 {
+			return nil
+		}
+		log.Printf("[DEBUG] Rate limit buffer of %d %s requests reached, sleeping for %s (until %s)",
+			b.buffer, resource, delay, time.Now().Add(delay))
+		if err := sleepContext(req.Context(), delay); err != nil {
+			return err
+		}
+	}
+}
+
+func (b *rateLimitBudget) reserve(resource string) time.Duration {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	w, ok := b.windows[resource]
+	if !ok {
+		return 0
+	}
+	if !time.Now().Before(w.reset) {
+		delete(b.windows, resource)
+		return 0
+	}
+	if w.remaining <= b.buffer {
+		return time.Until(w.reset)
+	}
+
+	w.remaining--
+	return 0
+}
+
+// update records the rate limit state reported by the response.
+func (b *rateLimitBudget) update(req *http.Request, resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	resetUnix, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	reset := time.Unix(resetUnix, 0)
+
+	resource := resp.Header.Get("X-RateLimit-Resource")
+	if resource == "" {
+		resource = rateLimitResource(req)
+	}
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	// Responses to parallel requests may arrive out of order, so within the
+	// same window only ever lower the remaining count.
+	if w, ok := b.windows[resource]; ok && w.reset.Equal(reset) && w.remaining < remaining {
+		return
+	}
+	b.windows[resource] = &rateLimitWindow{remaining: remaining, reset: reset}
+}
+
+// rateLimitResource guesses the rate limit resource a request counts against
+// before its response tells for sure.
+func rateLimitResource(req *http.Request) string {
+	switch {
+	case strings.HasSuffix(strings.TrimSuffix(req.URL.Path, "/"), "/graphql"):
+		return "graphql"
+	case strings.HasPrefix(req.URL.Path, "/search/code"), strings.HasPrefix(req.URL.Path, "/api/v3/search/code"):
+		return "code_search"
+	case strings.HasPrefix(req.URL.Path, "/search/"), strings.HasPrefix(req.URL.Path, "/api/v3/search/"):
+		return "search"
+	}
+	return "core"
+}
+
+// sleepContext sleeps for the given delay, unless the context is done first,
+// in which case it returns the error of the context.
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // drainBody reads all of b to memory and then returns two equivalent
 // ReadClosers yielding the same bytes.
 func drainBody(b io.ReadCloser) (r1, r2 io.ReadCloser, err error) {
@@ -267,12 +429,71 @@ func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 			return resp, err
 		}
 
-		time.Sleep(t.retryDelay)
+		if retry == t.maxRetries {
+			break
+		}
+
+		delay := t.nextRetryDelay(resp, retry)
+		if resp != nil {
+			// The response is discarded in favour of the retried one
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		log.Printf("[DEBUG] Retrying %s %s in %s (attempt %d of %d)", req.Method, req.URL, delay, retry+1, t.maxRetries)
+		if err := sleepContext(req.Context(), delay); err != nil {
+			return nil, err
+		}
 	}
 
 	return resp, err
 }
 
+// maxRetryDelay caps the delay between retries, however long the response
+// asks to wait. Rate limits are waited out by the RateLimitTransport instead.
+const maxRetryDelay = time.Minute
+
+// nextRetryDelay honours the Retry-After header sent with the response, and
+// otherwise backs off exponentially from retryDelay, up to maxRetryDelay.
+func (t *RetryTransport) nextRetryDelay(resp *http.Response, retry int) time.Duration {
+	delay := t.retryDelay
+	for i := 0; i < retry && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			delay = retryAfter
+		}
+	}
+
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		return time.Duration(seconds * float64(time.Second)), true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
 // WithMaxRetries is used to set the max number of retries when encountering an error
 func WithMaxRetries(d int) RetryTransportOption {
 	return func(rt *RetryTransport) {
@@ -293,3 +514,53 @@ func WithRetryDelay(d time.Duration) RetryTransportOption {
 		rt.retryDelay = d
 	}
 }
+
+const (
+	fineGrainedTokenPrefix = "github_pat_"
+
+	// fineGrainedTokenForbiddenMessage is returned by GitHub for endpoints
+	// that can't be used with a fine-grained personal access token, or that
+	// require a permission the token wasn't granted.
+	fineGrainedTokenForbiddenMessage = "Resource not accessible by personal access token"
+)
+
+// fineGrainedTokenTransport makes errors returned to fine-grained personal
+// access tokens more actionable by explaining their likely cause.
+type fineGrainedTokenTransport struct {
+	transport http.RoundTripper
+}
+
+func NewFineGrainedTokenTransport(rt http.RoundTripper) *fineGrainedTokenTransport {
+	return &fineGrainedTokenTransport{transport: rt}
+}
+
+func (t *fineGrainedTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusForbidden {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var data map[string]interface{}
+	if json.Unmarshal(body, &data) != nil || data["message"] != fineGrainedTokenForbiddenMessage {
+		return resp, nil
+	}
+
+	data["message"] = fineGrainedTokenForbiddenMessage + ". The fine-grained personal access token is either missing a " +
+		"permission required by this endpoint, or the endpoint doesn't support fine-grained tokens. " +
+		"Check the token's permissions and resource owner, or use a classic personal access token or GitHub App instead"
+	body, err = json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+
+	return resp, nil
+}
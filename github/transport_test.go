@@ -2,12 +2,14 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -272,6 +274,45 @@ func TestRateLimitTransport_abuseLimit_post_error(t *testing.T) {
 		t.Fatalf("Expected message %q, got: %q", expectedMessage, ghErr.Message)
 	}
 }
+func TestRateLimitTransport_secondaryRateLimit(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/test/blah",
+			ResponseBody: `{
+  "message": "You have exceeded a secondary rate limit. Please wait a few minutes before you try again."
+}`,
+			StatusCode: 429,
+			ResponseHeaders: map[string]string{
+				"Retry-After": "0.1",
+			},
+		},
+		{
+			ExpectedUri:  "/repos/test/blah",
+			ResponseBody: `{"id": 1234}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	// Retrying isn't enabled, so the rate limit transport has to wait out
+	// the secondary rate limit on its own.
+	httpClient := &http.Client{Transport: NewRetryTransport(NewRateLimitTransport(http.DefaultTransport))}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	ctx := context.WithValue(context.Background(), ctxId, t.Name())
+	r, _, err := client.Repositories.Get(ctx, "test", "blah")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.GetID() != 1234 {
+		t.Fatalf("Expected ID to be 1234, got: %d", r.GetID())
+	}
+}
+
 func TestRateLimitTransport_smart_lock(t *testing.T) {
 	t.Run("With parallelRequests true it does not lock the rate limit transport", func(t *testing.T) {
 		rlt := NewRateLimitTransport(http.DefaultTransport, WithParallelRequests(true))
@@ -461,6 +502,113 @@ func TestRetryTransport_retry_post_success(t *testing.T) {
 	}
 }
 
+func TestRetryTransport_retry_after(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/repos/test/blah",
+			ResponseBody: `{"message": "bad gateway"}`,
+			StatusCode:   502,
+			ResponseHeaders: map[string]string{
+				"Retry-After": "0.1",
+			},
+		},
+		{
+			ExpectedUri:  "/repos/test/blah",
+			ResponseBody: `{"message": "service unavailable"}`,
+			StatusCode:   503,
+			ResponseHeaders: map[string]string{
+				"Retry-After": "0.1",
+			},
+		},
+		{
+			ExpectedUri:  "/repos/test/blah",
+			ResponseBody: `{"id": 1234}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	// The retry delay is long enough for the test to time out unless the
+	// Retry-After header takes precedence.
+	httpClient := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, WithMaxRetries(2), WithRetryDelay(time.Hour))}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	ctx := context.WithValue(context.Background(), ctxId, t.Name())
+	r, _, err := client.Repositories.Get(ctx, "test", "blah")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.GetID() != 1234 {
+		t.Fatalf("Expected ID to be 1234, got: %d", r.GetID())
+	}
+}
+
+func TestRetryTransport_context(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/repos/test/blah",
+			ResponseBody: `{"message": "bad gateway"}`,
+			StatusCode:   502,
+			ResponseHeaders: map[string]string{
+				"Retry-After": "30",
+			},
+		},
+	})
+	defer ts.Close()
+
+	httpClient := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, WithMaxRetries(1))}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err := client.Repositories.Get(ctx, "test", "blah")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the deadline to be exceeded, got %v", err)
+	}
+}
+
+func TestRetryTransport_nextRetryDelay(t *testing.T) {
+	rt := NewRetryTransport(http.DefaultTransport, WithRetryDelay(time.Second))
+
+	if delay := rt.nextRetryDelay(nil, 2); delay != 4*time.Second {
+		t.Fatalf("Expected 4s, got %s", delay)
+	}
+	if delay := rt.nextRetryDelay(nil, 100); delay != maxRetryDelay {
+		t.Fatalf("Expected the backoff to be capped at %s, got %s", maxRetryDelay, delay)
+	}
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3600")
+	if delay := rt.nextRetryDelay(resp, 0); delay != maxRetryDelay {
+		t.Fatalf("Expected Retry-After to be capped at %s, got %s", maxRetryDelay, delay)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if delay, ok := parseRetryAfter("2"); !ok || delay != 2*time.Second {
+		t.Fatalf("Expected 2s, got %s (%t)", delay, ok)
+	}
+
+	if delay, ok := parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)); !ok || delay <= 59*time.Minute {
+		t.Fatalf("Expected about an hour, got %s (%t)", delay, ok)
+	}
+
+	if _, ok := parseRetryAfter(""); ok {
+		t.Fatal("Expected an empty header not to be parsed")
+	}
+
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Fatal("Expected an invalid header not to be parsed")
+	}
+}
+
 type mockResponse struct {
 	ExpectedUri     string
 	ExpectedMethod  string
@@ -471,3 +619,124 @@ type mockResponse struct {
 	ResponseHeaders map[string]string
 	ResponseBody    string
 }
+
+func TestFineGrainedTokenTransport_forbidden(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/test/blah",
+			ResponseBody: `{
+  "message": "Resource not accessible by personal access token",
+  "documentation_url": "https://docs.github.com/rest/repos/repos#get-a-repository"
+}`,
+			StatusCode: 403,
+		},
+	})
+	defer ts.Close()
+
+	httpClient := &http.Client{Transport: NewFineGrainedTokenTransport(http.DefaultTransport)}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	ctx := context.WithValue(context.Background(), ctxId, t.Name())
+	_, _, err := client.Repositories.Get(ctx, "test", "blah")
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+
+	ghErr, ok := err.(*github.ErrorResponse)
+	if !ok {
+		t.Fatalf("Expected *github.ErrorResponse, got %T", err)
+	}
+	if !strings.HasPrefix(ghErr.Message, fineGrainedTokenForbiddenMessage+". ") {
+		t.Fatalf("Expected message to explain the error, got %q", ghErr.Message)
+	}
+	if ghErr.DocumentationURL != "https://docs.github.com/rest/repos/repos#get-a-repository" {
+		t.Fatalf("Expected documentation URL to be preserved, got %q", ghErr.DocumentationURL)
+	}
+}
+
+func TestRateLimitBudget(t *testing.T) {
+	rateLimitResponse := func(remaining int, reset time.Time, resource string) *http.Response {
+		header := http.Header{}
+		header.Set("X-RateLimit-Remaining", fmt.Sprint(remaining))
+		header.Set("X-RateLimit-Reset", fmt.Sprint(reset.Unix()))
+		if resource != "" {
+			header.Set("X-RateLimit-Resource", resource)
+		}
+		return &http.Response{Header: header}
+	}
+
+	restReq, _ := http.NewRequest("GET", "https://api.github.com/repos/test/blah", nil)
+	graphqlReq, _ := http.NewRequest("POST", "https://api.github.com/graphql", nil)
+
+	t.Run("reserves requests until only the buffer is left", func(t *testing.T) {
+		b := newRateLimitBudget(3)
+		b.update(restReq, rateLimitResponse(5, time.Now().Add(time.Hour), "core"))
+
+		for i := 0; i < 2; i++ {
+			if delay := b.reserve("core"); delay != 0 {
+				t.Fatalf("Expected request %d not to wait, got %s", i, delay)
+			}
+		}
+		if delay := b.reserve("core"); delay <= 0 {
+			t.Fatal("Expected request to wait for the rate limit to reset")
+		}
+		if delay := b.reserve("graphql"); delay != 0 {
+			t.Fatalf("Expected graphql request not to wait, got %s", delay)
+		}
+	})
+
+	t.Run("does not wait once the rate limit has reset", func(t *testing.T) {
+		b := newRateLimitBudget(3)
+		b.update(graphqlReq, rateLimitResponse(0, time.Now().Add(-time.Second), ""))
+
+		if delay := b.reserve("graphql"); delay != 0 {
+			t.Fatalf("Expected request not to wait, got %s", delay)
+		}
+	})
+
+	t.Run("ignores stale responses within the same window", func(t *testing.T) {
+		reset := time.Now().Add(time.Hour)
+		b := newRateLimitBudget(1)
+		b.update(restReq, rateLimitResponse(2, reset, "core"))
+		b.update(restReq, rateLimitResponse(10, reset, "core"))
+
+		if delay := b.reserve("core"); delay != 0 {
+			t.Fatalf("Expected request not to wait, got %s", delay)
+		}
+		if delay := b.reserve("core"); delay <= 0 {
+			t.Fatal("Expected request to wait for the rate limit to reset")
+		}
+	})
+
+	t.Run("stops waiting once the request context is done", func(t *testing.T) {
+		rlt := NewRateLimitTransport(http.DefaultTransport, WithRateLimitBuffer(1))
+		rlt.budget.update(restReq, rateLimitResponse(1, time.Now().Add(time.Hour), "core"))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, "GET", "https://api.github.com/repos/test/blah", nil)
+
+		_, err := rlt.RoundTrip(req)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Expected the deadline to be exceeded, got %v", err)
+		}
+	})
+
+	t.Run("guesses the rate limit resource of requests", func(t *testing.T) {
+		searchReq, _ := http.NewRequest("GET", "https://github.example.com/api/v3/search/repositories", nil)
+		codeSearchReq, _ := http.NewRequest("GET", "https://api.github.com/search/code", nil)
+		for req, expected := range map[*http.Request]string{
+			restReq:       "core",
+			graphqlReq:    "graphql",
+			searchReq:     "search",
+			codeSearchReq: "code_search",
+		} {
+			if actual := rateLimitResource(req); actual != expected {
+				t.Fatalf("Expected %s to count against %q, got %q", req.URL, expected, actual)
+			}
+		}
+	})
+}
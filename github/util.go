@@ -281,3 +281,63 @@ func deleteResourceOn404AndSwallow304OtherwiseReturnError(err error, d *schema.R
 	}
 	return err
 }
+
+// recordPartialUpdate refreshes the state of an authoritative resource after
+// an update failed part-way through, so that the changes that were applied
+// are recorded and only the remaining ones are retried on the next apply.
+// The original error is always returned.
+func recordPartialUpdate(err error, d *schema.ResourceData, meta interface{}, read schema.ReadFunc) error {
+	log.Printf("[WARN] Update of %s failed part-way, refreshing state to record applied changes: %s", d.Id(), err)
+	if readErr := read(d, meta); readErr != nil {
+		log.Printf("[WARN] Unable to refresh state of %s after partial update: %s", d.Id(), readErr)
+	}
+
+	return err
+}
+
+// isAlreadyExistsError reports whether err is a validation error returned by
+// GitHub because the object being created already exists.
+func isAlreadyExistsError(err error) bool {
+	ghErr, ok := err.(*github.ErrorResponse)
+	if !ok || ghErr.Response.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	for _, e := range ghErr.Errors {
+		if e.Code == "already_exists" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// computedSchemaFromResourceSchema returns a copy of a resource schema in
+// which every attribute, including those of nested blocks, is computed-only.
+// It allows data sources to mirror the shape of the corresponding resource
+// without duplicating its schema.
+func computedSchemaFromResourceSchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(rs))
+	for k, v := range rs {
+		ds[k] = computedSchema(v)
+	}
+
+	return ds
+}
+
+func computedSchema(rs *schema.Schema) *schema.Schema {
+	ds := &schema.Schema{
+		Type:        rs.Type,
+		Computed:    true,
+		Description: rs.Description,
+		Sensitive:   rs.Sensitive,
+	}
+
+	switch elem := rs.Elem.(type) {
+	case *schema.Resource:
+		ds.Elem = &schema.Resource{Schema: computedSchemaFromResourceSchema(elem.Schema)}
+	case *schema.Schema:
+		ds.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return ds
+}
@@ -0,0 +1,210 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v65/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The fork pull request workflow endpoints are not supported by go-github yet,
+// so requests are made with the generic client. Repository and organization
+// settings share the same shape and only differ in their path prefix, e.g.
+// "repos/owner/repo/actions/permissions" or "orgs/org/actions/permissions".
+
+const defaultForkPullRequestApprovalPolicy = "first_time_contributors"
+
+type forkPullRequestContributorApproval struct {
+	ApprovalPolicy string `json:"approval_policy"`
+}
+
+type forkPullRequestWorkflowsPrivateRepos struct {
+	RunWorkflowsFromForkPullRequests  bool `json:"run_workflows_from_fork_pull_requests"`
+	SendWriteTokensToWorkflows        bool `json:"send_write_tokens_to_workflows"`
+	SendSecretsAndVariables           bool `json:"send_secrets_and_variables"`
+	RequireApprovalForForkPRWorkflows bool `json:"require_approval_for_fork_pr_workflows"`
+}
+
+func forkPullRequestWorkflowsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"approval_policy": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  defaultForkPullRequestApprovalPolicy,
+			ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{
+				"first_time_contributors_new_to_github",
+				"first_time_contributors",
+				"all_external_contributors",
+			}, false), "approval_policy"),
+			Description: "Which outside contributors need approval before workflows run on their fork pull requests. " +
+				"Can be one of 'first_time_contributors_new_to_github', 'first_time_contributors' or 'all_external_contributors'.",
+		},
+		"private_repositories": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Settings for workflows on fork pull requests in private and internal repositories.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"run_workflows_from_fork_pull_requests": {
+						Type:        schema.TypeBool,
+						Required:    true,
+						Description: "Whether workflows run on pull requests from forks.",
+					},
+					"send_write_tokens_to_workflows": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether workflows on pull requests from forks get a token with write permissions.",
+					},
+					"send_secrets_and_variables": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether secrets and variables are sent to workflows on pull requests from forks.",
+					},
+					"require_approval_for_fork_pr_workflows": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether workflows on pull requests from forks require approval to run.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func getForkPullRequestContributorApproval(ctx context.Context, client *github.Client, prefix string) (*forkPullRequestContributorApproval, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("%s/fork-pr-contributor-approval", prefix), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	approval := new(forkPullRequestContributorApproval)
+	_, err = client.Do(ctx, req, approval)
+	if err != nil {
+		return nil, err
+	}
+
+	return approval, nil
+}
+
+func setForkPullRequestContributorApproval(ctx context.Context, client *github.Client, prefix string, approval *forkPullRequestContributorApproval) error {
+	req, err := client.NewRequest("PUT", fmt.Sprintf("%s/fork-pr-contributor-approval", prefix), approval)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
+
+func getForkPullRequestWorkflowsPrivateRepos(ctx context.Context, client *github.Client, prefix string) (*forkPullRequestWorkflowsPrivateRepos, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("%s/fork-pr-workflows-private-repos", prefix), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	workflows := new(forkPullRequestWorkflowsPrivateRepos)
+	_, err = client.Do(ctx, req, workflows)
+	if err != nil {
+		return nil, err
+	}
+
+	return workflows, nil
+}
+
+func setForkPullRequestWorkflowsPrivateRepos(ctx context.Context, client *github.Client, prefix string, workflows *forkPullRequestWorkflowsPrivateRepos) error {
+	req, err := client.NewRequest("PUT", fmt.Sprintf("%s/fork-pr-workflows-private-repos", prefix), workflows)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
+
+func expandForkPullRequestWorkflowsPrivateRepos(input []interface{}) *forkPullRequestWorkflowsPrivateRepos {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	m := input[0].(map[string]interface{})
+	return &forkPullRequestWorkflowsPrivateRepos{
+		RunWorkflowsFromForkPullRequests:  m["run_workflows_from_fork_pull_requests"].(bool),
+		SendWriteTokensToWorkflows:        m["send_write_tokens_to_workflows"].(bool),
+		SendSecretsAndVariables:           m["send_secrets_and_variables"].(bool),
+		RequireApprovalForForkPRWorkflows: m["require_approval_for_fork_pr_workflows"].(bool),
+	}
+}
+
+func flattenForkPullRequestWorkflowsPrivateRepos(workflows *forkPullRequestWorkflowsPrivateRepos) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"run_workflows_from_fork_pull_requests":  workflows.RunWorkflowsFromForkPullRequests,
+			"send_write_tokens_to_workflows":         workflows.SendWriteTokensToWorkflows,
+			"send_secrets_and_variables":             workflows.SendSecretsAndVariables,
+			"require_approval_for_fork_pr_workflows": workflows.RequireApprovalForForkPRWorkflows,
+		},
+	}
+}
+
+// applyForkPullRequestWorkflows writes the configured fork pull request
+// workflow settings below prefix. The private repository settings are only
+// written when configured, as they can't be set on public repositories.
+func applyForkPullRequestWorkflows(ctx context.Context, client *github.Client, prefix string, d *schema.ResourceData) error {
+	err := setForkPullRequestContributorApproval(ctx, client, prefix, &forkPullRequestContributorApproval{
+		ApprovalPolicy: d.Get("approval_policy").(string),
+	})
+	if err != nil {
+		return err
+	}
+
+	if workflows := expandForkPullRequestWorkflowsPrivateRepos(d.Get("private_repositories").([]interface{})); workflows != nil {
+		return setForkPullRequestWorkflowsPrivateRepos(ctx, client, prefix, workflows)
+	}
+
+	return nil
+}
+
+// readForkPullRequestWorkflows reads the fork pull request workflow settings
+// below prefix into d.
+func readForkPullRequestWorkflows(ctx context.Context, client *github.Client, prefix string, d *schema.ResourceData) error {
+	approval, err := getForkPullRequestContributorApproval(ctx, client, prefix)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("approval_policy", approval.ApprovalPolicy); err != nil {
+		return err
+	}
+
+	if len(d.Get("private_repositories").([]interface{})) == 0 {
+		return nil
+	}
+
+	workflows, err := getForkPullRequestWorkflowsPrivateRepos(ctx, client, prefix)
+	if err != nil {
+		return err
+	}
+	return d.Set("private_repositories", flattenForkPullRequestWorkflowsPrivateRepos(workflows))
+}
+
+// resetForkPullRequestWorkflows restores the default fork pull request
+// workflow settings below prefix.
+func resetForkPullRequestWorkflows(ctx context.Context, client *github.Client, prefix string, d *schema.ResourceData) error {
+	err := setForkPullRequestContributorApproval(ctx, client, prefix, &forkPullRequestContributorApproval{
+		ApprovalPolicy: defaultForkPullRequestApprovalPolicy,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(d.Get("private_repositories").([]interface{})) > 0 {
+		return setForkPullRequestWorkflowsPrivateRepos(ctx, client, prefix, &forkPullRequestWorkflowsPrivateRepos{})
+	}
+
+	return nil
+}
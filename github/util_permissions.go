@@ -5,6 +5,10 @@ const (
 	pushPermission  string = "push"
 	writePermission string = "write"
 	readPermission  string = "read"
+
+	triagePermission   string = "triage"
+	maintainPermission string = "maintain"
+	adminPermission    string = "admin"
 )
 
 func getPermission(permission string) string {
@@ -19,3 +23,13 @@ func getPermission(permission string) string {
 
 	return permission
 }
+
+// isBaseRepositoryPermission reports whether permission is one of the
+// built-in repository roles rather than a custom repository role.
+func isBaseRepositoryPermission(permission string) bool {
+	switch getPermission(permission) {
+	case pullPermission, triagePermission, pushPermission, maintainPermission, adminPermission:
+		return true
+	}
+	return false
+}
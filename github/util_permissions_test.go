@@ -0,0 +1,26 @@
+package github
+
+import "testing"
+
+func TestIsBaseRepositoryPermission(t *testing.T) {
+	cases := []struct {
+		Permission string
+		Expected   bool
+	}{
+		{Permission: "pull", Expected: true},
+		{Permission: "read", Expected: true},
+		{Permission: "triage", Expected: true},
+		{Permission: "push", Expected: true},
+		{Permission: "write", Expected: true},
+		{Permission: "maintain", Expected: true},
+		{Permission: "admin", Expected: true},
+		{Permission: "security-reviewer", Expected: false},
+		{Permission: "", Expected: false},
+	}
+
+	for _, tc := range cases {
+		if got := isBaseRepositoryPermission(tc.Permission); got != tc.Expected {
+			t.Fatalf("isBaseRepositoryPermission(%q): expected %t, got %t", tc.Permission, tc.Expected, got)
+		}
+	}
+}
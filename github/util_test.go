@@ -1,9 +1,12 @@
 package github
 
 import (
+	"errors"
+	"net/http"
 	"testing"
 	"unicode"
 
+	"github.com/google/go-github/v65/github"
 	"github.com/hashicorp/go-cty/cty"
 )
 
@@ -91,6 +94,48 @@ func TestAccGithubUtilTwoPartID(t *testing.T) {
 	}
 }
 
+func TestAccGithubUtilIsAlreadyExistsError(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected bool
+	}{
+		{
+			err: &github.ErrorResponse{
+				Response: &http.Response{StatusCode: http.StatusUnprocessableEntity},
+				Errors:   []github.Error{{Resource: "Label", Code: "already_exists", Field: "name"}},
+			},
+			expected: true,
+		},
+		{
+			err: &github.ErrorResponse{
+				Response: &http.Response{StatusCode: http.StatusUnprocessableEntity},
+				Errors:   []github.Error{{Resource: "Label", Code: "invalid", Field: "color"}},
+			},
+			expected: false,
+		},
+		{
+			err: &github.ErrorResponse{
+				Response: &http.Response{StatusCode: http.StatusNotFound},
+			},
+			expected: false,
+		},
+		{
+			err:      errors.New("unexpected error"),
+			expected: false,
+		},
+		{
+			err:      nil,
+			expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := isAlreadyExistsError(tc.err); actual != tc.expected {
+			t.Errorf("Expected isAlreadyExistsError(%v) to be %t, got %t", tc.err, tc.expected, actual)
+		}
+	}
+}
+
 func flipUsernameCase(username string) string {
 	oc := []rune(username)
 